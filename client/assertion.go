@@ -0,0 +1,131 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// Assertion is a check run on every response of a monitor. Exactly one of
+// its fields is set, it is encoded in the shape the API expects for that
+// type of assertion.
+type Assertion struct {
+	Status   *StatusAssertion
+	Header   *HeaderAssertion
+	TextBody *TextBodyAssertion
+	JsonBody *JsonBodyAssertion
+	// Other holds an assertion of a type this package does not support yet,
+	// as returned by the API, so a new type does not fail the decoding of
+	// the monitor.
+	Other json.RawMessage
+}
+
+// StatusAssertion compares the status code of the response to Target, with
+// eq, not_eq, gt, gte, lt or lte.
+type StatusAssertion struct {
+	Compare string `json:"compare"`
+	Target  int64  `json:"target"`
+}
+
+// HeaderAssertion compares the response header named Key to Target.
+type HeaderAssertion struct {
+	Key     string `json:"key"`
+	Compare string `json:"compare"`
+	Target  string `json:"target"`
+}
+
+// TextBodyAssertion compares the response body to Target.
+type TextBodyAssertion struct {
+	Compare string `json:"compare"`
+	Target  string `json:"target"`
+}
+
+// JsonBodyAssertion compares the value found at the JSONPath Path of the
+// response body, such as $.status, to Target.
+type JsonBodyAssertion struct {
+	Path    string `json:"path"`
+	Compare string `json:"compare"`
+	Target  string `json:"target"`
+}
+
+// Types of assertions, as sent in the type property.
+const (
+	AssertionStatus   = "status"
+	AssertionHeader   = "header"
+	AssertionTextBody = "textBody"
+	AssertionJsonBody = "jsonBody"
+)
+
+// Type returns the type of the assertion, or "" when no field is set.
+func (a Assertion) Type() string {
+	switch {
+	case a.Other != nil:
+		var typed struct {
+			Type string `json:"type"`
+		}
+		json.Unmarshal(a.Other, &typed)
+		return typed.Type
+	case a.Status != nil:
+		return AssertionStatus
+	case a.Header != nil:
+		return AssertionHeader
+	case a.TextBody != nil:
+		return AssertionTextBody
+	case a.JsonBody != nil:
+		return AssertionJsonBody
+	}
+	return ""
+}
+
+func (a Assertion) MarshalJSON() ([]byte, error) {
+	if a.Other != nil {
+		return a.Other, nil
+	}
+	var value interface{}
+	switch a.Type() {
+	case AssertionStatus:
+		value = a.Status
+	case AssertionHeader:
+		value = a.Header
+	case AssertionTextBody:
+		value = a.TextBody
+	case AssertionJsonBody:
+		value = a.JsonBody
+	default:
+		return nil, errors.New("empty assertion")
+	}
+
+	// The fields of the assertion are merged with its type.
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(fmt.Sprintf(`{"type":%q,`, a.Type())), b[1:]...), nil
+}
+
+func (a *Assertion) UnmarshalJSON(b []byte) error {
+	var typed struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(b, &typed); err != nil {
+		return err
+	}
+
+	*a = Assertion{}
+	switch typed.Type {
+	case AssertionStatus:
+		a.Status = &StatusAssertion{}
+		return json.Unmarshal(b, a.Status)
+	case AssertionHeader:
+		a.Header = &HeaderAssertion{}
+		return json.Unmarshal(b, a.Header)
+	case AssertionTextBody:
+		a.TextBody = &TextBodyAssertion{}
+		return json.Unmarshal(b, a.TextBody)
+	case AssertionJsonBody:
+		a.JsonBody = &JsonBodyAssertion{}
+		return json.Unmarshal(b, a.JsonBody)
+	}
+	a.Other = append(json.RawMessage(nil), b...)
+	return nil
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestAssertionJSON(t *testing.T) {
+	tests := []struct {
+		assertion Assertion
+		json      string
+	}{
+		{
+			Assertion{Status: &StatusAssertion{Compare: "eq", Target: 200}},
+			`{"type":"status","compare":"eq","target":200}`,
+		},
+		{
+			Assertion{Header: &HeaderAssertion{Key: "content-type", Compare: "contains", Target: "json"}},
+			`{"type":"header","key":"content-type","compare":"contains","target":"json"}`,
+		},
+		{
+			Assertion{TextBody: &TextBodyAssertion{Compare: "not_empty"}},
+			`{"type":"textBody","compare":"not_empty","target":""}`,
+		},
+		{
+			Assertion{JsonBody: &JsonBodyAssertion{Path: "$.status", Compare: "eq", Target: "ok"}},
+			`{"type":"jsonBody","path":"$.status","compare":"eq","target":"ok"}`,
+		},
+	}
+
+	for _, test := range tests {
+		b, err := json.Marshal(test.assertion)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != test.json {
+			t.Errorf("got %s, want %s", b, test.json)
+		}
+
+		var decoded Assertion
+		if err := json.Unmarshal([]byte(test.json), &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(decoded, test.assertion) {
+			t.Errorf("%s decoded as %+v", test.json, decoded)
+		}
+	}
+
+	// An unknown type is kept as is.
+	var other Assertion
+	if err := json.Unmarshal([]byte(`{"type":"latency","compare":"lt","target":500}`), &other); err != nil {
+		t.Fatal(err)
+	}
+	if other.Type() != "latency" {
+		t.Errorf("unexpected type %q", other.Type())
+	}
+	if b, err := json.Marshal(other); err != nil || string(b) != `{"type":"latency","compare":"lt","target":500}` {
+		t.Errorf("got %s, %v", b, err)
+	}
+	if _, err := json.Marshal(Assertion{}); err == nil {
+		t.Error("expected an error for an empty assertion")
+	}
+}
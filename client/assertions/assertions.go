@@ -0,0 +1,188 @@
+// Package assertions evaluates monitor assertions against a response, the
+// way OpenStatus does when a monitor runs:
+//
+//	check, err := c.CheckHttp(ctx, client.CheckHttpRequest{Url: monitor.Url})
+//	for _, failure := range assertions.Evaluate(monitor.Assertions, check.Response[0]) {
+//		fmt.Println(failure)
+//	}
+//
+// Status codes are compared as numbers. Headers, bodies and JSON values are
+// compared as strings, lexicographically for gt, gte, lt and lte.
+package assertions
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+// Failure is an assertion the response does not satisfy.
+type Failure struct {
+	// Index is the position of the assertion in the evaluated list.
+	Index     int
+	Assertion client.Assertion
+	// Actual is the value found in the response.
+	Actual string
+	// Reason explains why the assertion could not be evaluated, if so.
+	Reason string
+}
+
+func (f Failure) Error() string {
+	if f.Reason != "" {
+		return describe(f.Assertion) + ": " + f.Reason
+	}
+	compare, target := comparison(f.Assertion)
+	switch {
+	case f.Assertion.Status != nil:
+		return fmt.Sprintf("status is %s, expected %s %s", f.Actual, compare, target)
+	case compare == "empty" || compare == "not_empty":
+		return fmt.Sprintf("%s is %q, expected %s", describe(f.Assertion), f.Actual, compare)
+	}
+	return fmt.Sprintf("%s is %q, expected %s %q", describe(f.Assertion), f.Actual, compare, target)
+}
+
+// Evaluate returns the assertions response fails, in order.
+func Evaluate(list []client.Assertion, response client.CheckResponse) []Failure {
+	var failures []Failure
+	for i, assertion := range list {
+		actual, ok, reason := evaluate(assertion, response)
+		if !ok {
+			failures = append(failures, Failure{Index: i, Assertion: assertion, Actual: actual, Reason: reason})
+		}
+	}
+	return failures
+}
+
+func evaluate(assertion client.Assertion, response client.CheckResponse) (string, bool, string) {
+	switch {
+	case assertion.Status != nil:
+		actual := strconv.Itoa(response.Status)
+		ok, err := compareNumbers(assertion.Status.Compare, int64(response.Status), assertion.Status.Target)
+		if err != nil {
+			return actual, false, err.Error()
+		}
+		return actual, ok, ""
+
+	case assertion.Header != nil:
+		actual := header(response.Headers, assertion.Header.Key)
+		ok, err := compareStrings(assertion.Header.Compare, actual, assertion.Header.Target)
+		if err != nil {
+			return actual, false, err.Error()
+		}
+		return actual, ok, ""
+
+	case assertion.TextBody != nil:
+		ok, err := compareStrings(assertion.TextBody.Compare, response.Body, assertion.TextBody.Target)
+		if err != nil {
+			return response.Body, false, err.Error()
+		}
+		return response.Body, ok, ""
+
+	case assertion.JsonBody != nil:
+		actual, found, err := jsonPath(response.Body, assertion.JsonBody.Path)
+		if err != nil {
+			return "", false, err.Error()
+		}
+		if !found {
+			// A missing value only satisfies empty.
+			return "", assertion.JsonBody.Compare == "empty", "no value at " + assertion.JsonBody.Path
+		}
+		ok, err := compareStrings(assertion.JsonBody.Compare, actual, assertion.JsonBody.Target)
+		if err != nil {
+			return actual, false, err.Error()
+		}
+		return actual, ok, ""
+
+	case assertion.Other != nil:
+		// The type is not supported here, only the API evaluates it.
+		return "", true, ""
+	}
+	return "", false, "empty assertion"
+}
+
+func compareNumbers(compare string, actual, target int64) (bool, error) {
+	switch compare {
+	case "eq":
+		return actual == target, nil
+	case "not_eq":
+		return actual != target, nil
+	case "gt":
+		return actual > target, nil
+	case "gte":
+		return actual >= target, nil
+	case "lt":
+		return actual < target, nil
+	case "lte":
+		return actual <= target, nil
+	}
+	return false, fmt.Errorf("unsupported comparison %q", compare)
+}
+
+func compareStrings(compare, actual, target string) (bool, error) {
+	switch compare {
+	case "eq":
+		return actual == target, nil
+	case "not_eq":
+		return actual != target, nil
+	case "gt":
+		return actual > target, nil
+	case "gte":
+		return actual >= target, nil
+	case "lt":
+		return actual < target, nil
+	case "lte":
+		return actual <= target, nil
+	case "contains":
+		return strings.Contains(actual, target), nil
+	case "not_contains":
+		return !strings.Contains(actual, target), nil
+	case "empty":
+		return actual == "", nil
+	case "not_empty":
+		return actual != "", nil
+	}
+	return false, fmt.Errorf("unsupported comparison %q", compare)
+}
+
+// header looks key up case-insensitively, as HTTP header names are.
+func header(headers map[string]string, key string) string {
+	if value, ok := headers[key]; ok {
+		return value
+	}
+	for name, value := range headers {
+		if strings.EqualFold(name, key) {
+			return value
+		}
+	}
+	return ""
+}
+
+func describe(assertion client.Assertion) string {
+	switch {
+	case assertion.Status != nil:
+		return "status"
+	case assertion.Header != nil:
+		return "header " + assertion.Header.Key
+	case assertion.TextBody != nil:
+		return "body"
+	case assertion.JsonBody != nil:
+		return "JSON body at " + assertion.JsonBody.Path
+	}
+	return "assertion"
+}
+
+func comparison(assertion client.Assertion) (string, string) {
+	switch {
+	case assertion.Status != nil:
+		return assertion.Status.Compare, strconv.FormatInt(assertion.Status.Target, 10)
+	case assertion.Header != nil:
+		return assertion.Header.Compare, assertion.Header.Target
+	case assertion.TextBody != nil:
+		return assertion.TextBody.Compare, assertion.TextBody.Target
+	case assertion.JsonBody != nil:
+		return assertion.JsonBody.Compare, assertion.JsonBody.Target
+	}
+	return "", ""
+}
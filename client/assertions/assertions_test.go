@@ -0,0 +1,74 @@
+package assertions
+
+import (
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+func TestEvaluate(t *testing.T) {
+	response := client.CheckResponse{
+		Status:  404,
+		Body:    `{"status":"degraded","items":[{"name":"db","up":false}],"count":2}`,
+		Headers: map[string]string{"Content-Type": "application/json"},
+	}
+
+	tests := []struct {
+		assertion client.Assertion
+		pass      bool
+	}{
+		{client.Assertion{Status: &client.StatusAssertion{Compare: "eq", Target: 404}}, true},
+		{client.Assertion{Status: &client.StatusAssertion{Compare: "not_eq", Target: 404}}, false},
+		{client.Assertion{Status: &client.StatusAssertion{Compare: "lt", Target: 400}}, false},
+		{client.Assertion{Status: &client.StatusAssertion{Compare: "gte", Target: 400}}, true},
+		{client.Assertion{Status: &client.StatusAssertion{Compare: "contains", Target: 4}}, false},
+
+		{client.Assertion{Header: &client.HeaderAssertion{Key: "content-type", Compare: "contains", Target: "json"}}, true},
+		{client.Assertion{Header: &client.HeaderAssertion{Key: "Content-Type", Compare: "eq", Target: "text/html"}}, false},
+		{client.Assertion{Header: &client.HeaderAssertion{Key: "x-missing", Compare: "empty"}}, true},
+		{client.Assertion{Header: &client.HeaderAssertion{Key: "x-missing", Compare: "not_empty"}}, false},
+
+		{client.Assertion{TextBody: &client.TextBodyAssertion{Compare: "contains", Target: "degraded"}}, true},
+		{client.Assertion{TextBody: &client.TextBodyAssertion{Compare: "not_contains", Target: "degraded"}}, false},
+		{client.Assertion{TextBody: &client.TextBodyAssertion{Compare: "gt", Target: "{"}}, true},
+
+		{client.Assertion{JsonBody: &client.JsonBodyAssertion{Path: "$.status", Compare: "eq", Target: "ok"}}, false},
+		{client.Assertion{JsonBody: &client.JsonBodyAssertion{Path: "$.items[0].name", Compare: "eq", Target: "db"}}, true},
+		{client.Assertion{JsonBody: &client.JsonBodyAssertion{Path: "$['items'][0]['up']", Compare: "eq", Target: "false"}}, true},
+		{client.Assertion{JsonBody: &client.JsonBodyAssertion{Path: "$.count", Compare: "gte", Target: "2"}}, true},
+		{client.Assertion{JsonBody: &client.JsonBodyAssertion{Path: "$.missing", Compare: "empty"}}, true},
+		{client.Assertion{JsonBody: &client.JsonBodyAssertion{Path: "$.missing", Compare: "not_eq", Target: "x"}}, false},
+		{client.Assertion{JsonBody: &client.JsonBodyAssertion{Path: "status", Compare: "eq", Target: "ok"}}, false},
+	}
+
+	for _, test := range tests {
+		failures := Evaluate([]client.Assertion{test.assertion}, response)
+		if pass := len(failures) == 0; pass != test.pass {
+			t.Errorf("%s: expected pass=%t, got %v", describe(test.assertion), test.pass, failures)
+		}
+	}
+
+	failures := Evaluate([]client.Assertion{
+		{Status: &client.StatusAssertion{Compare: "eq", Target: 200}},
+		{TextBody: &client.TextBodyAssertion{Compare: "not_empty"}},
+		{JsonBody: &client.JsonBodyAssertion{Path: "$.status", Compare: "eq", Target: "ok"}},
+	}, response)
+	if len(failures) != 2 || failures[0].Index != 0 || failures[1].Index != 2 {
+		t.Fatalf("unexpected failures %v", failures)
+	}
+	if got, want := failures[0].Error(), "status is 404, expected eq 200"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if got, want := failures[1].Error(), `JSON body at $.status is "degraded", expected eq "ok"`; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestJsonPathNotJSON(t *testing.T) {
+	failures := Evaluate([]client.Assertion{
+		{JsonBody: &client.JsonBodyAssertion{Path: "$.status", Compare: "eq", Target: "ok"}},
+	}, client.CheckResponse{Body: "<html>"})
+	if len(failures) != 1 || failures[0].Reason == "" {
+		t.Errorf("expected a failure with a reason, got %v", failures)
+	}
+}
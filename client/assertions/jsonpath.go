@@ -0,0 +1,97 @@
+package assertions
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonPath returns the value found at path in body, as a string. It supports
+// the common subset of JSONPath: $ followed by .name, ['name'] and [index]
+// segments.
+func jsonPath(body, path string) (string, bool, error) {
+	segments, err := parsePath(path)
+	if err != nil {
+		return "", false, err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal([]byte(body), &value); err != nil {
+		return "", false, fmt.Errorf("body is not JSON: %w", err)
+	}
+
+	for _, segment := range segments {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			var ok bool
+			if value, ok = v[segment]; !ok {
+				return "", false, nil
+			}
+		case []interface{}:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(v) {
+				return "", false, nil
+			}
+			value = v[i]
+		default:
+			return "", false, nil
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v, true, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true, nil
+	case bool:
+		return strconv.FormatBool(v), true, nil
+	case nil:
+		return "", true, nil
+	}
+	b, _ := json.Marshal(value)
+	return string(b), true, nil
+}
+
+// parsePath splits a path such as $.items[0]['name'] into items, 0, name.
+func parsePath(path string) ([]string, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return nil, fmt.Errorf("invalid JSONPath %q, expected it to start with $", path)
+	}
+
+	var segments []string
+	for rest != "" {
+		switch {
+		case rest[0] == '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid JSONPath %q, empty name", path)
+			}
+			segments = append(segments, rest[:end])
+			rest = rest[end:]
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end == -1 {
+				return nil, fmt.Errorf("invalid JSONPath %q, missing ]", path)
+			}
+			segment := rest[1:end]
+			if unquoted, ok := strings.CutPrefix(segment, "'"); ok {
+				segment = strings.TrimSuffix(unquoted, "'")
+			} else if unquoted, ok := strings.CutPrefix(segment, `"`); ok {
+				segment = strings.TrimSuffix(unquoted, `"`)
+			} else if _, err := strconv.Atoi(segment); err != nil {
+				return nil, fmt.Errorf("invalid JSONPath %q, unsupported selector [%s]", path, segment)
+			}
+			segments = append(segments, segment)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid JSONPath %q", path)
+		}
+	}
+	return segments, nil
+}
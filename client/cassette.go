@@ -0,0 +1,365 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Redacted replaces the value of sensitive headers in cassettes.
+const Redacted = "REDACTED"
+
+// ErrNoInteraction is returned by Replay for a request missing from the
+// cassettes. It is never retried.
+var ErrNoInteraction = errors.New("no recorded interaction left")
+
+// ReplayProgress is the file of the cassette directory where Replay keeps
+// the interactions already replayed, one per line.
+const ReplayProgress = "replay-progress"
+
+// sensitiveHeaders are never written to a cassette.
+var sensitiveHeaders = []string{
+	"x-openstatus-key",
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveFields are the JSON fields of request and response bodies never
+// written to a cassette.
+var sensitiveFields = []string{
+	"password",
+	"integration_key",
+	"integrationKey",
+	"webhook_url",
+	"webhookUrl",
+}
+
+// sensitivePayloads are the fields of a notification payload holding a
+// secret, the webhook URL or integration key of the channel.
+var sensitivePayloads = []string{
+	"slack",
+	"discord",
+	"pagerduty",
+}
+
+// Interaction is one HTTP exchange stored in a cassette.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+type RecordedResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// Record writes every exchange to a cassette, a JSON file created in dir
+// with the first request. Sensitive headers such as x-openstatus-key are
+// redacted, and so are the secrets of the bodies such as status page
+// passwords, notification webhooks and monitor headers like Authorization. Install it last so it records what is sent on the wire.
+func Record(dir string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		var (
+			mu           sync.Mutex
+			file         string
+			interactions []Interaction
+		)
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			var requestBody []byte
+			if req.Body != nil {
+				var err error
+				requestBody, err = io.ReadAll(req.Body)
+				req.Body.Close()
+				if err != nil {
+					return nil, err
+				}
+				req = req.Clone(req.Context())
+				req.Body = io.NopCloser(bytes.NewReader(requestBody))
+			}
+
+			resp, err := next.RoundTrip(req)
+			if err != nil {
+				return nil, err
+			}
+			responseBody, err := io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if err != nil {
+				return nil, err
+			}
+			resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+			mu.Lock()
+			defer mu.Unlock()
+			if file == "" {
+				if err := os.MkdirAll(dir, 0o755); err != nil {
+					return nil, fmt.Errorf("creating cassette directory: %w", err)
+				}
+				// A new recording session is replayed from the start.
+				if err := os.Remove(filepath.Join(dir, ReplayProgress)); err != nil && !errors.Is(err, os.ErrNotExist) {
+					return nil, fmt.Errorf("resetting replay progress: %w", err)
+				}
+				file = filepath.Join(dir, time.Now().UTC().Format("20060102T150405.000000000")+".json")
+			}
+			interactions = append(interactions, Interaction{
+				Request: RecordedRequest{
+					Method: req.Method,
+					URL:    req.URL.String(),
+					Header: redact(req.Header),
+					Body:   redactBody(requestBody),
+				},
+				Response: RecordedResponse{
+					StatusCode: resp.StatusCode,
+					Header:     redact(resp.Header),
+					Body:       redactBody(responseBody),
+				},
+			})
+			// The whole cassette is rewritten so it stays valid JSON if the
+			// process is killed.
+			b, err := json.MarshalIndent(interactions, "", "  ")
+			if err != nil {
+				return nil, err
+			}
+			if err := os.WriteFile(file, b, 0o600); err != nil {
+				return nil, fmt.Errorf("writing cassette: %w", err)
+			}
+			return resp, nil
+		})
+	}
+}
+
+// Replay answers requests from the cassettes found in dir instead of calling
+// the API. Cassettes are read in the order they were recorded, and a request
+// gets the first interaction not replayed yet with the same method, path and
+// body, or else with the same method and path. Requests with no such
+// interaction fail.
+//
+// Terraform configures the provider again in every plan and apply, so the
+// interactions replayed are kept in the ReplayProgress file of dir: each
+// Replay continues where the previous one stopped, and a resource read again
+// after an update gets the updated response. Delete the file to replay the
+// session from the start.
+func Replay(dir string) (Middleware, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+
+	var (
+		interactions []Interaction
+		keys         []string
+	)
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		var cassette []Interaction
+		if err := json.Unmarshal(b, &cassette); err != nil {
+			return nil, fmt.Errorf("reading cassette %s: %w", file, err)
+		}
+		for i := range cassette {
+			keys = append(keys, fmt.Sprintf("%s:%d", filepath.Base(file), i))
+		}
+		interactions = append(interactions, cassette...)
+	}
+	if len(interactions) == 0 {
+		return nil, fmt.Errorf("no cassette found in %s", dir)
+	}
+
+	progress := filepath.Join(dir, ReplayProgress)
+	done := map[string]bool{}
+	if b, err := os.ReadFile(progress); err == nil {
+		for _, key := range strings.Fields(string(b)) {
+			done[key] = true
+		}
+	} else if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("reading replay progress: %w", err)
+	}
+
+	var mu sync.Mutex
+	return func(http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			var body []byte
+			if req.Body != nil {
+				var err error
+				body, err = io.ReadAll(req.Body)
+				req.Body.Close()
+				if err != nil {
+					return nil, err
+				}
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			// Bodies holding the current time, such as the date of a status
+			// report update, differ on every run: without a recording of the
+			// same body, the first one for the method and path is replayed.
+			match := -1
+			redacted := redactBody(body)
+			for i, interaction := range interactions {
+				if done[keys[i]] || interaction.Request.Method != req.Method || !samePath(interaction.Request.URL, req) {
+					continue
+				}
+				if sameBody(interaction.Request.Body, redacted) {
+					match = i
+					break
+				}
+				if match < 0 {
+					match = i
+				}
+			}
+			if match >= 0 {
+				interaction := interactions[match]
+				done[keys[match]] = true
+				if err := appendLine(progress, keys[match]); err != nil {
+					return nil, fmt.Errorf("writing replay progress: %w", err)
+				}
+				header := interaction.Response.Header.Clone()
+				if header == nil {
+					header = http.Header{}
+				}
+				return &http.Response{
+					Status:        fmt.Sprintf("%d %s", interaction.Response.StatusCode, http.StatusText(interaction.Response.StatusCode)),
+					StatusCode:    interaction.Response.StatusCode,
+					Proto:         "HTTP/1.1",
+					ProtoMajor:    1,
+					ProtoMinor:    1,
+					Header:        header,
+					Body:          io.NopCloser(strings.NewReader(interaction.Response.Body)),
+					ContentLength: int64(len(interaction.Response.Body)),
+					Request:       req,
+				}, nil
+			}
+			return nil, fmt.Errorf("%w for %s %s", ErrNoInteraction, req.Method, req.URL.RequestURI())
+		})
+	}, nil
+}
+
+// sameBody reports whether the recorded body is the redacted body of the
+// request, comparing JSON bodies by value.
+func sameBody(recorded, body string) bool {
+	if recorded == body {
+		return true
+	}
+	var a, b interface{}
+	if json.Unmarshal([]byte(recorded), &a) != nil || json.Unmarshal([]byte(body), &b) != nil {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func appendLine(file, line string) error {
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(line + "\n"); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// samePath reports whether the recorded URL has the path and query of req,
+// so cassettes replay whatever the endpoint.
+func samePath(recorded string, req *http.Request) bool {
+	r, err := req.URL.Parse(recorded)
+	return err == nil && r.RequestURI() == req.URL.RequestURI()
+}
+
+func redact(header http.Header) http.Header {
+	header = header.Clone()
+	for _, name := range sensitiveHeaders {
+		if header.Get(name) != "" {
+			header.Set(name, Redacted)
+		}
+	}
+	return header
+}
+
+// redactBody replaces the secrets of a JSON body with Redacted. Other bodies,
+// and JSON bodies without secrets, are returned unchanged.
+func redactBody(body []byte) string {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v interface{}
+	if len(body) == 0 || decoder.Decode(&v) != nil || !redactValue(v) {
+		return string(body)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(b)
+}
+
+// redactValue redacts the secrets found in a decoded JSON value, and reports
+// whether there was any.
+func redactValue(v interface{}) bool {
+	redacted := false
+	switch v := v.(type) {
+	case []interface{}:
+		for _, element := range v {
+			redacted = redactValue(element) || redacted
+		}
+	case map[string]interface{}:
+		// A monitor header such as {"key": "Authorization", "value": "..."}.
+		if key, ok := v["key"].(string); ok && sensitiveHeader(key) {
+			redacted = redactField(v, "value") || redacted
+		}
+		for key, value := range v {
+			switch {
+			case slices.Contains(sensitiveFields, key):
+				redacted = redactField(v, key) || redacted
+			case key == "payload":
+				if payload, ok := value.(map[string]interface{}); ok {
+					for _, field := range sensitivePayloads {
+						redacted = redactField(payload, field) || redacted
+					}
+				}
+			default:
+				redacted = redactValue(value) || redacted
+			}
+		}
+	}
+	return redacted
+}
+
+// redactField redacts the field of object when it is a non-empty string.
+func redactField(object map[string]interface{}, field string) bool {
+	if value, ok := object[field].(string); !ok || value == "" || value == Redacted {
+		return false
+	}
+	object[field] = Redacted
+	return true
+}
+
+func sensitiveHeader(name string) bool {
+	for _, sensitive := range sensitiveHeaders {
+		if strings.EqualFold(name, sensitive) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,234 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/client/fakeapi"
+)
+
+func TestRecordReplay(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	server := fakeapi.NewServer("secret-token")
+	recording := client.New("secret-token", client.WithEndpoint(server.Endpoint()), client.WithMiddleware(client.Record(dir)))
+	created, err := recording.CreateMonitor(ctx, client.MonitorRequest{Name: "api", Url: "https://example.com", Periodicity: "1m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recording.GetMonitor(ctx, "2"); !client.IsNotFound(err) {
+		t.Fatalf("expected a not found, got %v", err)
+	}
+	server.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("expected one cassette, got %v", files)
+	}
+	b, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret-token") || !strings.Contains(string(b), client.Redacted) {
+		t.Errorf("the API key was not redacted:\n%s", b)
+	}
+
+	replay, err := client.Replay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replaying := client.New("other-token", client.WithEndpoint("http://replay.invalid/v1/"), client.WithMiddleware(replay))
+	monitor, err := replaying.CreateMonitor(ctx, client.MonitorRequest{Name: "api", Url: "https://example.com", Periodicity: "1m"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if monitor.Id != created.Id || monitor.Method != "GET" {
+		t.Errorf("unexpected replayed monitor %+v", monitor)
+	}
+	if _, err := replaying.GetMonitor(ctx, "2"); !client.IsNotFound(err) {
+		t.Errorf("expected the replayed not found, got %v", err)
+	}
+	if _, err := replaying.GetMonitor(ctx, "2"); !errors.Is(err, client.ErrNoInteraction) {
+		t.Errorf("expected the cassette to be exhausted, got %v", err)
+	}
+}
+
+func TestReplayCreateThenUpdate(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	request := client.MonitorRequest{Name: "api", Url: "https://example.com", Periodicity: "1m"}
+	updated := request
+	updated.Name = "api v2"
+
+	// Terraform configures a new client for every plan and apply.
+	server := fakeapi.NewServer("secret-token")
+	record := func() *client.Client {
+		return client.New("secret-token", client.WithEndpoint(server.Endpoint()), client.WithMiddleware(client.Record(dir)))
+	}
+	created, err := record().CreateMonitor(ctx, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := strconv.FormatInt(created.Id, 10)
+	recording := record()
+	if _, err := recording.GetMonitor(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recording.UpdateMonitor(ctx, updated, id); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := record().GetMonitor(ctx, id); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	replay := func() *client.Client {
+		replay, err := client.Replay(dir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return client.New("other-token", client.WithEndpoint("http://replay.invalid/v1/"), client.WithMiddleware(replay))
+	}
+	if _, err := replay().CreateMonitor(ctx, request); err != nil {
+		t.Fatal(err)
+	}
+	replaying := replay()
+	monitor, err := replaying.GetMonitor(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if monitor.Name != "api" {
+		t.Errorf("expected the monitor before the update, got %+v", monitor)
+	}
+	if _, err := replaying.UpdateMonitor(ctx, updated, id); err != nil {
+		t.Fatal(err)
+	}
+	monitor, err = replay().GetMonitor(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if monitor.Name != "api v2" {
+		t.Errorf("expected the updated monitor, got %+v", monitor)
+	}
+
+	// Without the progress the session replays from the start.
+	if err := os.Remove(filepath.Join(dir, client.ReplayProgress)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := replay().CreateMonitor(ctx, request); err != nil {
+		t.Errorf("expected the session to replay from the start, got %v", err)
+	}
+}
+
+func TestReplayMatchesBodies(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	monitor := func(name string) client.MonitorRequest {
+		return client.MonitorRequest{Name: name, Url: "https://example.com", Periodicity: "1m"}
+	}
+
+	server := fakeapi.NewServer("secret-token")
+	recording := client.New("secret-token", client.WithEndpoint(server.Endpoint()), client.WithMiddleware(client.Record(dir)))
+	for _, name := range []string{"first", "second"} {
+		if _, err := recording.CreateMonitor(ctx, monitor(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	server.Close()
+
+	replay, err := client.Replay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replaying := client.New("other-token", client.WithEndpoint("http://replay.invalid/v1/"), client.WithMiddleware(replay))
+	created, err := replaying.CreateMonitor(ctx, monitor("second"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Name != "second" {
+		t.Errorf("expected the recording with the same body, got %+v", created)
+	}
+	// A body recorded with another value, such as the current time, falls
+	// back to the first recording of the method and path.
+	created, err = replaying.CreateMonitor(ctx, monitor("third"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Name != "first" {
+		t.Errorf("expected the first recording left, got %+v", created)
+	}
+	if _, err := replaying.CreateMonitor(ctx, monitor("second")); !errors.Is(err, client.ErrNoInteraction) {
+		t.Errorf("expected the cassette to be exhausted, got %v", err)
+	}
+}
+
+func TestRecordRedactsBodies(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	secrets := []string{
+		"page-password",
+		"https://hooks.slack.com/services/T000/B000/slack-secret",
+		"https://discord.com/api/webhooks/1/discord-secret",
+		"pagerduty-integration-key",
+		"Bearer monitor-secret",
+	}
+	page := client.PageRequest{Title: "Status", Slug: "status", PasswordProtected: true, Password: secrets[0]}
+
+	server := fakeapi.NewServer("secret-token")
+	recording := client.New("secret-token", client.WithEndpoint(server.Endpoint()), client.WithMiddleware(client.Record(dir)))
+	if _, err := recording.CreatePage(ctx, page); err != nil {
+		t.Fatal(err)
+	}
+	for _, notification := range []client.NotificationRequest{
+		{Name: "slack", Provider: "slack", Payload: client.NotificationPayload{Slack: secrets[1]}},
+		{Name: "discord", Provider: "discord", Payload: client.NotificationPayload{Discord: secrets[2]}},
+		{Name: "pagerduty", Provider: "pagerduty", Payload: client.NotificationPayload{Pagerduty: secrets[3]}},
+	} {
+		if _, err := recording.CreateNotification(ctx, notification); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := recording.CreateMonitor(ctx, client.MonitorRequest{Name: "api", Url: "https://example.com", Periodicity: "1m",
+		Headers: []client.Header{{Key: "authorization", Value: secrets[4]}, {Key: "Accept", Value: "application/json"}}}); err != nil {
+		t.Fatal(err)
+	}
+	server.Close()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 1 {
+		t.Fatalf("expected one cassette, got %v", files)
+	}
+	b, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range secrets {
+		if strings.Contains(string(b), secret) {
+			t.Errorf("%q was written to the cassette:\n%s", secret, b)
+		}
+	}
+	if !strings.Contains(string(b), "application/json") {
+		t.Errorf("a header without secret was redacted:\n%s", b)
+	}
+
+	// Requests still match the redacted recording.
+	replay, err := client.Replay(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	replaying := client.New("other-token", client.WithEndpoint("http://replay.invalid/v1/"), client.WithMiddleware(replay))
+	replayed, err := replaying.CreatePage(ctx, page)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if replayed.Password != client.Redacted || replayed.Slug != "status" {
+		t.Errorf("unexpected replayed page %+v", replayed)
+	}
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// CheckHttpRequest describes an ad-hoc http check.
+type CheckHttpRequest struct {
+	Url        string   `json:"url"`
+	Method     string   `json:"method,omitempty"`
+	Body       string   `json:"body,omitempty"`
+	Headers    []Header `json:"headers,omitempty"`
+	Regions    []string `json:"regions,omitempty"`
+	RunCount   int      `json:"runCount,omitempty"`
+	Aggregated bool     `json:"aggregated"`
+}
+
+// CheckHttpResponse holds the timings and responses of an ad-hoc check.
+type CheckHttpResponse struct {
+	Id         int64            `json:"id"`
+	Raw        []CheckTiming    `json:"raw"`
+	Response   CheckResponses   `json:"response"`
+	Aggregated *CheckAggregated `json:"aggregated"`
+}
+
+// CheckResponse is the response received by one region.
+type CheckResponse struct {
+	Timestamp float64           `json:"timestamp"`
+	Status    int               `json:"status"`
+	Latency   float64           `json:"latency"`
+	Body      string            `json:"body"`
+	Headers   map[string]string `json:"headers"`
+	Timing    CheckTiming       `json:"timing"`
+	Region    string            `json:"region"`
+}
+
+// CheckResponses holds the response of every region. The API documents a
+// single response object but returns one per region when checking several.
+type CheckResponses []CheckResponse
+
+func (r *CheckResponses) UnmarshalJSON(b []byte) error {
+	var responses []CheckResponse
+	if err := json.Unmarshal(b, &responses); err == nil {
+		*r = responses
+		return nil
+	}
+
+	var response CheckResponse
+	if err := json.Unmarshal(b, &response); err != nil {
+		return err
+	}
+	*r = CheckResponses{response}
+	return nil
+}
+
+// CheckTiming holds the timestamps, in milliseconds, of each phase of a request.
+type CheckTiming struct {
+	DnsStart          float64 `json:"dnsStart"`
+	DnsDone           float64 `json:"dnsDone"`
+	ConnectStart      float64 `json:"connectStart"`
+	ConnectDone       float64 `json:"connectDone"`
+	TlsHandshakeStart float64 `json:"tlsHandshakeStart"`
+	TlsHandshakeDone  float64 `json:"tlsHandshakeDone"`
+	FirstByteStart    float64 `json:"firstByteStart"`
+	FirstByteDone     float64 `json:"firstByteDone"`
+	TransferStart     float64 `json:"transferStart"`
+	TransferDone      float64 `json:"transferDone"`
+}
+
+// CheckAggregated holds the percentiles of each phase across the runs.
+type CheckAggregated struct {
+	Dns       CheckPercentiles `json:"dns"`
+	Connect   CheckPercentiles `json:"connect"`
+	Tls       CheckPercentiles `json:"tls"`
+	FirstByte CheckPercentiles `json:"firstByte"`
+	Transfer  CheckPercentiles `json:"transfer"`
+	Latency   CheckPercentiles `json:"latency"`
+}
+
+// CheckPercentiles are durations in milliseconds.
+type CheckPercentiles struct {
+	P50 float64 `json:"p50"`
+	P75 float64 `json:"p75"`
+	P95 float64 `json:"p95"`
+	P99 float64 `json:"p99"`
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// CheckHttp runs an ad-hoc http check from the requested regions.
+func (c *Client) CheckHttp(ctx context.Context, request CheckHttpRequest) (*CheckHttpResponse, error) {
+	var check CheckHttpResponse
+	if err := c.do(ctx, http.MethodPost, "check/http", &request, &check); err != nil {
+		return nil, err
+	}
+	return &check, nil
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	hreq "github.com/imroc/req/v3"
+)
+
+// DefaultEndpoint is the hosted OpenStatus API.
+const DefaultEndpoint = "https://api.openstatus.dev/v1/"
+
+// Client talks to the OpenStatus API. Every method sends its request with
+// the given context, so cancelling it aborts the call.
+type Client struct {
+	http *hreq.Client
+}
+
+// Option configures a Client built by New.
+type Option func(*options)
+
+type options struct {
+	endpoint   string
+	middleware []Middleware
+}
+
+// WithEndpoint sets the base URL of the API, for self-hosted instances.
+func WithEndpoint(endpoint string) Option {
+	return func(o *options) {
+		o.endpoint = endpoint
+	}
+}
+
+// WithMiddleware adds middleware around every request, the first one given
+// being the outermost.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(o *options) {
+		o.middleware = append(o.middleware, middleware...)
+	}
+}
+
+// New returns a Client authenticated with token. Retries and logging are
+// opt-in through WithMiddleware.
+func New(token string, opts ...Option) *Client {
+	o := options{endpoint: DefaultEndpoint}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	// Paths are resolved relative to the endpoint.
+	if !strings.HasSuffix(o.endpoint, "/") {
+		o.endpoint += "/"
+	}
+
+	c := hreq.C().SetBaseURL(o.endpoint)
+	middleware := append([]Middleware{Auth(token)}, o.middleware...)
+	// The last wrapper added is the outermost one.
+	for i := len(middleware) - 1; i >= 0; i-- {
+		c.GetTransport().WrapRoundTrip(hreq.HttpRoundTripWrapper(middleware[i]))
+	}
+	return &Client{http: c}
+}
+
+// do sends a request with body encoded as JSON, when not nil, and decodes
+// the response into out, when not nil.
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) error {
+	request := c.http.R()
+	if body != nil {
+		request.SetBody(body)
+	}
+	// The error is also held by the response, checked below.
+	response, _ := request.SetContext(ctx).Send(method, path)
+	if err := checkResponse(response); err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	return json.NewDecoder(response.Body).Decode(out)
+}
@@ -0,0 +1,16 @@
+// Package client is a Go SDK for the OpenStatus API.
+//
+// A Client authenticates with an API key and exposes a method per endpoint:
+//
+//	c := client.New(os.Getenv("OPENSTATUS_API_TOKEN"),
+//		client.WithMiddleware(client.Retry(client.DefaultRetryPolicy, nil)),
+//	)
+//	monitors, err := c.ListMonitors(ctx)
+//
+// Responses other than 2xx are returned as typed errors such as
+// *ErrNotFound, which all unwrap to *APIError.
+//
+// The Record middleware captures the exchanges of a client into cassettes,
+// with the API key redacted, and Replay serves them back without network
+// access, to debug a session or to test code offline.
+package client
@@ -0,0 +1,96 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	hreq "github.com/imroc/req/v3"
+)
+
+// APIError is the error body returned by the OpenStatus API for any non 2xx
+// response.
+type APIError struct {
+	StatusCode int    `json:"-"`
+	Code       string `json:"code"`
+	Message    string `json:"message"`
+	Docs       string `json:"docs"`
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("openstatus: %d: %s", e.StatusCode, e.Message)
+	if e.Code != "" {
+		msg = fmt.Sprintf("openstatus: %d %s: %s", e.StatusCode, e.Code, e.Message)
+	}
+	if e.Docs != "" {
+		msg += " (" + e.Docs + ")"
+	}
+	return msg
+}
+
+// ErrBadRequest is returned for a 400 response.
+type ErrBadRequest struct{ APIError }
+
+// ErrUnauthorized is returned for a 401 response.
+type ErrUnauthorized struct{ APIError }
+
+// ErrForbidden is returned for a 403 response.
+type ErrForbidden struct{ APIError }
+
+// ErrNotFound is returned for a 404 response.
+type ErrNotFound struct{ APIError }
+
+// ErrConflict is returned for a 409 response.
+type ErrConflict struct{ APIError }
+
+// ErrInternalServerError is returned for a 500 response.
+type ErrInternalServerError struct{ APIError }
+
+func (e *ErrBadRequest) Unwrap() error          { return &e.APIError }
+func (e *ErrUnauthorized) Unwrap() error        { return &e.APIError }
+func (e *ErrForbidden) Unwrap() error           { return &e.APIError }
+func (e *ErrNotFound) Unwrap() error            { return &e.APIError }
+func (e *ErrConflict) Unwrap() error            { return &e.APIError }
+func (e *ErrInternalServerError) Unwrap() error { return &e.APIError }
+
+// IsNotFound reports whether err is a 404 returned by the API.
+func IsNotFound(err error) bool {
+	var notFound *ErrNotFound
+	return errors.As(err, &notFound)
+}
+
+// checkResponse returns the transport error of the response, or the typed
+// API error when the status code is not 2xx.
+func checkResponse(response *hreq.Response) error {
+	if response.Err != nil {
+		return response.Err
+	}
+	if response.IsSuccessState() {
+		return nil
+	}
+
+	apiErr := APIError{StatusCode: response.StatusCode}
+	if body, err := response.ToBytes(); err == nil {
+		_ = json.Unmarshal(body, &apiErr)
+	}
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(response.StatusCode)
+	}
+
+	switch response.StatusCode {
+	case http.StatusBadRequest:
+		return &ErrBadRequest{apiErr}
+	case http.StatusUnauthorized:
+		return &ErrUnauthorized{apiErr}
+	case http.StatusForbidden:
+		return &ErrForbidden{apiErr}
+	case http.StatusNotFound:
+		return &ErrNotFound{apiErr}
+	case http.StatusConflict:
+		return &ErrConflict{apiErr}
+	case http.StatusInternalServerError:
+		return &ErrInternalServerError{apiErr}
+	}
+	return &apiErr
+}
@@ -0,0 +1,105 @@
+package fakeapi
+
+import (
+	"net/url"
+	"slices"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+func (s *Server) checkHttp(rt route) {
+	var request client.CheckHttpRequest
+	if !decode(rt, &request) {
+		return
+	}
+
+	var problems []string
+	if u, err := url.Parse(request.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		problems = append(problems, "Invalid url '"+request.Url+"', expected an http or https URL.")
+	}
+	if request.Method == "" {
+		request.Method = "GET"
+	}
+	if !slices.Contains(methods, request.Method) {
+		problems = append(problems, "Invalid method '"+request.Method+"'.")
+	}
+	if len(request.Regions) == 0 {
+		request.Regions = []string{"ams"}
+	}
+	for _, region := range request.Regions {
+		if !slices.Contains(regions, region) {
+			problems = append(problems, "Invalid region '"+region+"'.")
+		}
+	}
+	if request.RunCount == 0 {
+		request.RunCount = 1
+	}
+	if request.RunCount < 1 || request.RunCount > 5 {
+		problems = append(problems, "runCount must be between 1 and 5.")
+	}
+	if badRequest(rt.w, problems) {
+		return
+	}
+
+	response := client.CheckHttpResponse{Id: s.checks.nextId()}
+	for run := 0; run < request.RunCount; run++ {
+		for _, region := range request.Regions {
+			result := s.Check(request, region)
+			response.Raw = append(response.Raw, result.Timing)
+			if run == 0 {
+				response.Response = append(response.Response, result)
+			}
+		}
+	}
+	if request.Aggregated {
+		response.Aggregated = aggregate(response.Raw)
+	}
+	s.checks.Put(response.Id, response)
+	writeJSON(rt.w, response)
+}
+
+// defaultCheck answers 200 after 10ms spent in each phase.
+func defaultCheck(request client.CheckHttpRequest, region string) client.CheckResponse {
+	const start = 1700000000000
+	timing := client.CheckTiming{
+		DnsStart: start, DnsDone: start + 10,
+		ConnectStart: start + 10, ConnectDone: start + 20,
+		TlsHandshakeStart: start + 20, TlsHandshakeDone: start + 30,
+		FirstByteStart: start + 30, FirstByteDone: start + 40,
+		TransferStart: start + 40, TransferDone: start + 50,
+	}
+	return client.CheckResponse{
+		Timestamp: start,
+		Status:    200,
+		Latency:   50,
+		Body:      "",
+		Headers:   map[string]string{"Content-Type": "text/html"},
+		Timing:    timing,
+		Region:    region,
+	}
+}
+
+func aggregate(timings []client.CheckTiming) *client.CheckAggregated {
+	phase := func(duration func(client.CheckTiming) float64) client.CheckPercentiles {
+		values := make([]float64, 0, len(timings))
+		for _, timing := range timings {
+			values = append(values, duration(timing))
+		}
+		slices.Sort(values)
+		at := func(p float64) float64 {
+			return values[int(p*float64(len(values)-1))]
+		}
+		return client.CheckPercentiles{
+			P50: at(0.50), P75: at(0.75), P95: at(0.95), P99: at(0.99),
+			Min: values[0], Max: values[len(values)-1],
+		}
+	}
+	return &client.CheckAggregated{
+		Dns:       phase(func(t client.CheckTiming) float64 { return t.DnsDone - t.DnsStart }),
+		Connect:   phase(func(t client.CheckTiming) float64 { return t.ConnectDone - t.ConnectStart }),
+		Tls:       phase(func(t client.CheckTiming) float64 { return t.TlsHandshakeDone - t.TlsHandshakeStart }),
+		FirstByte: phase(func(t client.CheckTiming) float64 { return t.FirstByteDone - t.FirstByteStart }),
+		Transfer:  phase(func(t client.CheckTiming) float64 { return t.TransferDone - t.TransferStart }),
+		Latency:   phase(func(t client.CheckTiming) float64 { return t.TransferDone - t.DnsStart }),
+	}
+}
@@ -0,0 +1,63 @@
+package fakeapi
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+// userId is the member of the workspace owning the token, recorded as the
+// one acknowledging and resolving incidents.
+const userId = 1
+
+// AddIncident opens an incident on the monitor, as the API does when a
+// monitor fails. The API offers no way to create one.
+func (s *Server) AddIncident(monitorId int64) client.IncidentRequest {
+	startedAt := time.Now().UTC().Format(time.RFC3339)
+	incident := client.IncidentRequest{
+		Id:        s.Incidents.nextId(),
+		StartedAt: &startedAt,
+		MonitorId: &monitorId,
+	}
+	s.Incidents.Put(incident.Id, incident)
+	return incident
+}
+
+func (s *Server) updateIncident(rt route) {
+	incident, ok := s.Incidents.Get(rt.id)
+	if !ok {
+		writeNotFound(rt.w, "incident", rt.id)
+		return
+	}
+	var update client.IncidentUpdateRequest
+	if !decode(rt, &update) {
+		return
+	}
+
+	var problems []string
+	for name, at := range map[string]*string{"acknowledgedAt": update.AcknowledgedAt, "resolvedAt": update.ResolvedAt} {
+		if at == nil {
+			continue
+		}
+		if _, err := time.Parse(time.RFC3339, *at); err != nil {
+			problems = append(problems, fmt.Sprintf("Invalid %s '%s', expected ISO 8601.", name, *at))
+		}
+	}
+	if badRequest(rt.w, problems) {
+		return
+	}
+
+	incident.AcknowledgedAt, incident.AcknowledgedBy = update.AcknowledgedAt, userFor(update.AcknowledgedAt)
+	incident.ResolvedAt, incident.ResolvedBy = update.ResolvedAt, userFor(update.ResolvedAt)
+	s.Incidents.Put(incident.Id, incident)
+	writeJSON(rt.w, incident)
+}
+
+func userFor(at *string) *int64 {
+	if at == nil {
+		return nil
+	}
+	id := int64(userId)
+	return &id
+}
@@ -0,0 +1,126 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+var (
+	periodicities = []string{"30s", "1m", "5m", "10m", "30m", "1h"}
+	methods       = []string{"GET", "POST", "HEAD"}
+	regions       = client.RegionCodes()
+)
+
+func (s *Server) createMonitor(rt route) {
+	var monitor client.MonitorRequest
+	if !decode(rt, &monitor) || badRequest(rt.w, validateMonitor(&monitor)) {
+		return
+	}
+	monitor.Id = s.Monitors.nextId()
+	s.Monitors.Put(monitor.Id, monitor)
+	writeJSON(rt.w, monitor)
+}
+
+func (s *Server) updateMonitor(rt route) {
+	if _, ok := s.Monitors.Get(rt.id); !ok {
+		writeNotFound(rt.w, "monitor", rt.id)
+		return
+	}
+	var monitor client.MonitorRequest
+	if !decode(rt, &monitor) || badRequest(rt.w, validateMonitor(&monitor)) {
+		return
+	}
+	monitor.Id = rt.id
+	s.Monitors.Put(monitor.Id, monitor)
+	writeJSON(rt.w, monitor)
+}
+
+func (s *Server) deleteMonitor(rt route) {
+	if !s.Monitors.Delete(rt.id) {
+		writeNotFound(rt.w, "monitor", rt.id)
+		return
+	}
+	s.MonitorSummaries.Delete(rt.id)
+	writeJSON(rt.w, struct{}{})
+}
+
+func (s *Server) getMonitorSummary(rt route) {
+	if _, ok := s.Monitors.Get(rt.id); !ok {
+		writeNotFound(rt.w, "monitor", rt.id)
+		return
+	}
+	summary, ok := s.MonitorSummaries.Get(rt.id)
+	if !ok {
+		summary = client.MonitorSummary{Data: []client.MonitorSummaryDay{}}
+	}
+	writeJSON(rt.w, summary)
+}
+
+// validateMonitor checks monitor and fills in the defaults of the API.
+func validateMonitor(monitor *client.MonitorRequest) []string {
+	var problems []string
+
+	if monitor.Name == "" {
+		problems = append(problems, "Missing required field 'name'.")
+	}
+	if !slices.Contains(periodicities, monitor.Periodicity) {
+		problems = append(problems, "Invalid periodicity '"+monitor.Periodicity+"', expected one of "+strings.Join(periodicities, ", ")+".")
+	}
+
+	if monitor.Type == "" {
+		monitor.Type = "http"
+	}
+	switch monitor.Type {
+	case "http":
+		if u, err := url.Parse(monitor.Url); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			problems = append(problems, "Invalid url '"+monitor.Url+"', expected an http or https URL.")
+		}
+	case "tcp":
+		if host, port, ok := strings.Cut(monitor.Url, ":"); !ok || host == "" || port == "" {
+			problems = append(problems, "Invalid url '"+monitor.Url+"', expected host:port for a tcp monitor.")
+		}
+	default:
+		problems = append(problems, "Invalid jobType '"+monitor.Type+"', expected http or tcp.")
+	}
+
+	if monitor.Method == "" {
+		monitor.Method = "GET"
+	}
+	if !slices.Contains(methods, monitor.Method) {
+		problems = append(problems, "Invalid method '"+monitor.Method+"', expected one of "+strings.Join(methods, ", ")+".")
+	}
+
+	if monitor.Regions == nil {
+		monitor.Regions = []string{}
+	}
+	for _, region := range monitor.Regions {
+		if !slices.Contains(regions, region) {
+			problems = append(problems, "Invalid region '"+region+"'.")
+		}
+	}
+
+	if monitor.Timeout == 0 {
+		monitor.Timeout = client.DefaultMonitorTimeout
+	}
+	if monitor.Timeout < 0 || monitor.DegradedAfter < 0 {
+		problems = append(problems, "timeout and degradedAfter cannot be negative.")
+	}
+	if monitor.DegradedAfter > monitor.Timeout {
+		problems = append(problems, fmt.Sprintf("degradedAfter %d cannot exceed the timeout %d.", monitor.DegradedAfter, monitor.Timeout))
+	}
+
+	if monitor.Assertions == nil {
+		monitor.Assertions = []client.Assertion{}
+	}
+	for _, assertion := range monitor.Assertions {
+		if assertion.Status != nil && assertion.Status.Target <= 0 {
+			problems = append(problems, fmt.Sprintf("Invalid status assertion target %d.", assertion.Status.Target))
+		}
+	}
+
+	return problems
+}
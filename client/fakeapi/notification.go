@@ -0,0 +1,71 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+func (s *Server) createNotification(rt route) {
+	var notification client.NotificationRequest
+	if !decode(rt, &notification) || badRequest(rt.w, s.validateNotification(&notification)) {
+		return
+	}
+	notification.Id = s.Notifications.nextId()
+	s.Notifications.Put(notification.Id, notification)
+	writeJSON(rt.w, notification)
+}
+
+func (s *Server) validateNotification(notification *client.NotificationRequest) []string {
+	var problems []string
+	if notification.Name == "" {
+		problems = append(problems, "Missing required field 'name'.")
+	}
+
+	payload := notification.Payload
+	// The payload must only carry the field of the provider.
+	var value string
+	set := 0
+	for _, field := range []string{payload.Email, payload.Sms, payload.Slack, payload.Discord, payload.Pagerduty} {
+		if field != "" {
+			set++
+		}
+	}
+	switch notification.Provider {
+	case "email":
+		value = payload.Email
+		if _, err := mail.ParseAddress(value); value != "" && err != nil {
+			problems = append(problems, "Invalid email '"+value+"'.")
+		}
+	case "sms":
+		value = payload.Sms
+	case "slack":
+		value = payload.Slack
+	case "discord":
+		value = payload.Discord
+	case "pagerduty":
+		value = payload.Pagerduty
+	default:
+		problems = append(problems, "Invalid provider '"+notification.Provider+"', expected one of email, discord, slack, sms, pagerduty.")
+	}
+	if notification.Provider == "slack" || notification.Provider == "discord" {
+		if u, err := url.Parse(value); value != "" && (err != nil || u.Scheme != "https") {
+			problems = append(problems, "Invalid webhook url '"+value+"'.")
+		}
+	}
+	if value == "" || set != 1 {
+		problems = append(problems, "The payload must only hold the '"+notification.Provider+"' field.")
+	}
+
+	if notification.Monitors == nil {
+		notification.Monitors = []int64{}
+	}
+	for _, id := range notification.Monitors {
+		if _, ok := s.Monitors.Get(id); !ok {
+			problems = append(problems, fmt.Sprintf("No monitor with id %d.", id))
+		}
+	}
+	return problems
+}
@@ -0,0 +1,93 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"slices"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9-]+$`)
+
+func (s *Server) createPage(rt route) {
+	var page client.PageRequest
+	if !decode(rt, &page) || badRequest(rt.w, s.validatePage(&page)) || s.slugTaken(rt, page) {
+		return
+	}
+	page.Id = s.Pages.nextId()
+	s.Pages.Put(page.Id, page)
+	writeJSON(rt.w, page)
+}
+
+func (s *Server) updatePage(rt route) {
+	if _, ok := s.Pages.Get(rt.id); !ok {
+		writeNotFound(rt.w, "page", rt.id)
+		return
+	}
+	var page client.PageRequest
+	if !decode(rt, &page) {
+		return
+	}
+	page.Id = rt.id
+	if badRequest(rt.w, s.validatePage(&page)) || s.slugTaken(rt, page) {
+		return
+	}
+	s.Pages.Put(page.Id, page)
+	writeJSON(rt.w, page)
+}
+
+func (s *Server) subscribe(rt route) {
+	if _, ok := s.Pages.Get(rt.id); !ok {
+		writeNotFound(rt.w, "page", rt.id)
+		return
+	}
+	var subscriber client.PageSubscriberRequest
+	if !decode(rt, &subscriber) {
+		return
+	}
+	if _, err := mail.ParseAddress(subscriber.Email); err != nil {
+		writeError(rt.w, http.StatusBadRequest, "BAD_REQUEST", "Invalid email '"+subscriber.Email+"'.")
+		return
+	}
+	emails, _ := s.PageSubscribers.Get(rt.id)
+	if !slices.Contains(emails, subscriber.Email) {
+		s.PageSubscribers.Put(rt.id, append(slices.Clone(emails), subscriber.Email))
+	}
+	writeJSON(rt.w, subscriber)
+}
+
+func (s *Server) validatePage(page *client.PageRequest) []string {
+	var problems []string
+	if page.Title == "" {
+		problems = append(problems, "Missing required field 'title'.")
+	}
+	if !slugPattern.MatchString(page.Slug) {
+		problems = append(problems, "Invalid slug '"+page.Slug+"', only lowercase letters, digits and dashes are allowed.")
+	}
+	if page.PasswordProtected && page.Password == "" {
+		problems = append(problems, "A password is required to protect the page.")
+	}
+	if page.Monitors == nil {
+		page.Monitors = client.PageMonitors{}
+	}
+	for _, id := range page.Monitors {
+		if _, ok := s.Monitors.Get(id); !ok {
+			problems = append(problems, fmt.Sprintf("No monitor with id %d.", id))
+		}
+	}
+	return problems
+}
+
+// slugTaken answers 409 when another page already uses the slug of page.
+func (s *Server) slugTaken(rt route, page client.PageRequest) bool {
+	for _, other := range s.Pages.List() {
+		if other.Slug == page.Slug && other.Id != page.Id {
+			writeError(rt.w, http.StatusConflict, "CONFLICT", "The slug '"+page.Slug+"' is already used by another page.")
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,210 @@
+// Package fakeapi is an in-memory implementation of the OpenStatus API, to
+// test code using the client package or the provider without network
+// access or credentials:
+//
+//	server := fakeapi.NewServer("token")
+//	defer server.Close()
+//	c := client.New("token", client.WithEndpoint(server.Endpoint()))
+//
+// It validates requests like the API does and answers with the same error
+// bodies. The stores are exported so tests can seed objects or change them
+// behind the back of the code under test.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+// Server is a running fake of the OpenStatus API.
+type Server struct {
+	*httptest.Server
+
+	// Token is the API key expected in the x-openstatus-key header.
+	Token string
+
+	Monitors            *Store[client.MonitorRequest]
+	MonitorSummaries    *Store[client.MonitorSummary]
+	Pages               *Store[client.PageRequest]
+	PageSubscribers     *Store[[]string]
+	Notifications       *Store[client.NotificationRequest]
+	StatusReports       *Store[client.StatusReportRequest]
+	StatusReportUpdates *Store[client.StatusReportUpdateRequest]
+	Incidents           *Store[client.IncidentRequest]
+
+	// Check answers the ad-hoc checks for one region. By default every
+	// region gets a 200 with fixed timings, nothing is fetched.
+	Check func(request client.CheckHttpRequest, region string) client.CheckResponse
+
+	checks *Store[client.CheckHttpResponse]
+}
+
+// NewServer starts a fake accepting token as API key. Close it when done.
+func NewServer(token string) *Server {
+	s := &Server{
+		Token:               token,
+		Monitors:            newStore[client.MonitorRequest](),
+		MonitorSummaries:    newStore[client.MonitorSummary](),
+		Pages:               newStore[client.PageRequest](),
+		PageSubscribers:     newStore[[]string](),
+		Notifications:       newStore[client.NotificationRequest](),
+		StatusReports:       newStore[client.StatusReportRequest](),
+		StatusReportUpdates: newStore[client.StatusReportUpdateRequest](),
+		Incidents:           newStore[client.IncidentRequest](),
+		Check:               defaultCheck,
+		checks:              newStore[client.CheckHttpResponse](),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Endpoint is the base URL to give to client.WithEndpoint or to the
+// endpoint attribute of the provider.
+func (s *Server) Endpoint() string {
+	return s.URL + "/v1/"
+}
+
+// route is a request matched against a path such as monitor/:id/summary.
+type route struct {
+	w    http.ResponseWriter
+	r    *http.Request
+	id   int64
+	path string
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get("x-openstatus-key") != s.Token || s.Token == "" {
+		writeError(w, http.StatusUnauthorized, "UNAUTHORIZED", "Invalid or missing x-openstatus-key header")
+		return
+	}
+
+	path, ok := strings.CutPrefix(r.URL.Path, "/v1/")
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Unknown path "+r.URL.Path)
+		return
+	}
+
+	// Ids are replaced by :id so each route is matched by a fixed string.
+	segments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	rt := route{w: w, r: r}
+	if len(segments) > 1 {
+		if id, err := strconv.ParseInt(segments[1], 10, 64); err == nil {
+			rt.id = id
+			segments[1] = ":id"
+		}
+	}
+	rt.path = r.Method + " " + strings.Join(segments, "/")
+
+	switch rt.path {
+	case "GET monitor":
+		writeJSON(w, s.Monitors.List())
+	case "POST monitor":
+		s.createMonitor(rt)
+	case "GET monitor/:id":
+		getObject(rt, s.Monitors, "monitor")
+	case "PUT monitor/:id":
+		s.updateMonitor(rt)
+	case "DELETE monitor/:id":
+		s.deleteMonitor(rt)
+	case "GET monitor/:id/summary":
+		s.getMonitorSummary(rt)
+
+	case "GET page":
+		writeJSON(w, s.Pages.List())
+	case "POST page":
+		s.createPage(rt)
+	case "GET page/:id":
+		getObject(rt, s.Pages, "page")
+	case "PUT page/:id":
+		s.updatePage(rt)
+	case "POST page_subscriber/:id/update":
+		s.subscribe(rt)
+
+	case "GET notification":
+		writeJSON(w, s.Notifications.List())
+	case "POST notification":
+		s.createNotification(rt)
+	case "GET notification/:id":
+		getObject(rt, s.Notifications, "notification")
+
+	case "GET status_report":
+		writeJSON(w, s.StatusReports.List())
+	case "POST status_report":
+		s.createStatusReport(rt)
+	case "GET status_report/:id":
+		getObject(rt, s.StatusReports, "status report")
+	case "DELETE status_report/:id":
+		s.deleteStatusReport(rt)
+	case "POST status_report/:id/update":
+		s.updateStatusReport(rt)
+	case "GET status_report_update/:id":
+		getObject(rt, s.StatusReportUpdates, "status report update")
+	case "POST status_report_update":
+		s.createStatusReportUpdate(rt)
+
+	case "GET incident":
+		writeJSON(w, s.Incidents.List())
+	case "GET incident/:id":
+		getObject(rt, s.Incidents, "incident")
+	case "PUT incident/:id":
+		s.updateIncident(rt)
+
+	case "POST check/http":
+		s.checkHttp(rt)
+
+	default:
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "Unknown route "+r.Method+" "+r.URL.Path)
+	}
+}
+
+func getObject[T any](rt route, store *Store[T], kind string) {
+	item, ok := store.Get(rt.id)
+	if !ok {
+		writeNotFound(rt.w, kind, rt.id)
+		return
+	}
+	writeJSON(rt.w, item)
+}
+
+// decode reads the JSON body into v, answering 400 when it cannot.
+func decode(rt route, v interface{}) bool {
+	if err := json.NewDecoder(rt.r.Body).Decode(v); err != nil {
+		writeError(rt.w, http.StatusBadRequest, "BAD_REQUEST", "Invalid JSON body: "+err.Error())
+		return false
+	}
+	return true
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(client.APIError{
+		Code:    code,
+		Message: message,
+		Docs:    "https://docs.openstatus.dev/api-references/errors/code/" + code,
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, kind string, id int64) {
+	writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("No %s with id %d", kind, id))
+}
+
+// badRequest answers 400 with the first validation failure, if any.
+func badRequest(w http.ResponseWriter, problems []string) bool {
+	if len(problems) == 0 {
+		return false
+	}
+	writeError(w, http.StatusBadRequest, "BAD_REQUEST", problems[0])
+	return true
+}
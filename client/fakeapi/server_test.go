@@ -0,0 +1,75 @@
+package fakeapi_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/client/fakeapi"
+)
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+	server := fakeapi.NewServer("token")
+	defer server.Close()
+	c := client.New("token", client.WithEndpoint(server.Endpoint()))
+
+	monitor, err := c.CreateMonitor(ctx, client.MonitorRequest{
+		Name:        "api",
+		Url:         "https://example.com",
+		Periodicity: "1m",
+		Regions:     []string{"ams"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if monitor.Id != 1 || monitor.Method != "GET" || monitor.Type != "http" {
+		t.Errorf("unexpected monitor %+v", monitor)
+	}
+
+	_, err = c.CreateMonitor(ctx, client.MonitorRequest{Name: "api", Url: "https://example.com", Periodicity: "2m"})
+	var badRequest *client.ErrBadRequest
+	if !errors.As(err, &badRequest) || badRequest.Code != "BAD_REQUEST" || badRequest.Docs == "" {
+		t.Errorf("expected a bad request, got %v", err)
+	}
+
+	page := client.PageRequest{Title: "Status", Slug: "status", Monitors: client.PageMonitors{monitor.Id}}
+	if _, err := c.CreatePage(ctx, page); err != nil {
+		t.Fatal(err)
+	}
+	var conflict *client.ErrConflict
+	if _, err := c.CreatePage(ctx, page); !errors.As(err, &conflict) {
+		t.Errorf("expected a conflict, got %v", err)
+	}
+
+	report, err := c.CreateStatusReport(ctx, client.StatusReportRequest{Title: "Outage", Status: "investigating", Message: "Looking"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err = c.UpdateStatusReport(ctx, client.StatusReportUpdateRequest{Status: "resolved", Message: "Fixed"}, "1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Status != "resolved" || len(report.StatusReportUpdateIds) != 2 {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	check, err := c.CheckHttp(ctx, client.CheckHttpRequest{Url: "https://example.com", Regions: []string{"ams", "iad"}, RunCount: 2, Aggregated: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(check.Response) != 2 || len(check.Raw) != 4 || check.Aggregated == nil {
+		t.Errorf("unexpected check %+v", check)
+	}
+
+	server.Monitors.Delete(monitor.Id)
+	if _, err := c.GetMonitor(ctx, "1"); !client.IsNotFound(err) {
+		t.Errorf("expected a not found, got %v", err)
+	}
+
+	var unauthorized *client.ErrUnauthorized
+	if _, err := client.New("wrong", client.WithEndpoint(server.Endpoint())).ListMonitors(ctx); !errors.As(err, &unauthorized) {
+		t.Errorf("expected unauthorized, got %v", err)
+	}
+}
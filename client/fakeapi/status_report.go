@@ -0,0 +1,137 @@
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+var statuses = []string{"investigating", "identified", "monitoring", "resolved"}
+
+func (s *Server) createStatusReport(rt route) {
+	var report client.StatusReportRequest
+	if !decode(rt, &report) {
+		return
+	}
+
+	var problems []string
+	if report.Title == "" {
+		problems = append(problems, "Missing required field 'title'.")
+	}
+	problems = append(problems, validateUpdate(report.Status, report.Message, &report.Date)...)
+	if report.PageId != 0 {
+		if _, ok := s.Pages.Get(report.PageId); !ok {
+			problems = append(problems, fmt.Sprintf("No page with id %d.", report.PageId))
+		}
+	}
+	if report.MonitorIds == nil {
+		report.MonitorIds = []int64{}
+	}
+	for _, id := range report.MonitorIds {
+		if _, ok := s.Monitors.Get(id); !ok {
+			problems = append(problems, fmt.Sprintf("No monitor with id %d.", id))
+		}
+	}
+	if badRequest(rt.w, problems) {
+		return
+	}
+
+	report.Id = s.StatusReports.nextId()
+	update := s.addUpdate(report.Id, report.Status, report.Message, report.Date)
+	// The message and date only live in the updates.
+	report.Message, report.Date = "", ""
+	report.StatusReportUpdateIds = []int64{update}
+	s.StatusReports.Put(report.Id, report)
+	writeJSON(rt.w, report)
+}
+
+func (s *Server) deleteStatusReport(rt route) {
+	report, ok := s.StatusReports.Get(rt.id)
+	if !ok {
+		writeNotFound(rt.w, "status report", rt.id)
+		return
+	}
+	for _, id := range report.StatusReportUpdateIds {
+		s.StatusReportUpdates.Delete(id)
+	}
+	s.StatusReports.Delete(rt.id)
+	writeJSON(rt.w, struct{}{})
+}
+
+func (s *Server) updateStatusReport(rt route) {
+	var update client.StatusReportUpdateRequest
+	if !decode(rt, &update) {
+		return
+	}
+	report, ok := s.postUpdate(rt, rt.id, update)
+	if ok {
+		writeJSON(rt.w, report)
+	}
+}
+
+func (s *Server) createStatusReportUpdate(rt route) {
+	var update client.StatusReportUpdateRequest
+	if !decode(rt, &update) {
+		return
+	}
+	report, ok := s.postUpdate(rt, update.StatusReportId, update)
+	if !ok {
+		return
+	}
+	id := report.StatusReportUpdateIds[len(report.StatusReportUpdateIds)-1]
+	created, _ := s.StatusReportUpdates.Get(id)
+	writeJSON(rt.w, created)
+}
+
+// postUpdate adds update to the report and moves it to the status of the
+// update, answering the request itself on failure.
+func (s *Server) postUpdate(rt route, reportId int64, update client.StatusReportUpdateRequest) (client.StatusReportRequest, bool) {
+	report, ok := s.StatusReports.Get(reportId)
+	if !ok {
+		writeNotFound(rt.w, "status report", reportId)
+		return report, false
+	}
+	if badRequest(rt.w, validateUpdate(update.Status, update.Message, &update.Date)) {
+		return report, false
+	}
+
+	id := s.addUpdate(reportId, update.Status, update.Message, update.Date)
+	report.Status = update.Status
+	report.StatusReportUpdateIds = append(slices.Clone(report.StatusReportUpdateIds), id)
+	s.StatusReports.Put(report.Id, report)
+	return report, true
+}
+
+func (s *Server) addUpdate(reportId int64, status, message, date string) int64 {
+	id := s.StatusReportUpdates.nextId()
+	s.StatusReportUpdates.Put(id, client.StatusReportUpdateRequest{
+		Id:             json.Number(strconv.FormatInt(id, 10)),
+		Status:         status,
+		Date:           date,
+		Message:        message,
+		StatusReportId: reportId,
+	})
+	return id
+}
+
+// validateUpdate checks the fields shared by reports and their updates, the
+// date defaulting to now.
+func validateUpdate(status, message string, date *string) []string {
+	var problems []string
+	if !slices.Contains(statuses, status) {
+		problems = append(problems, "Invalid status '"+status+"', expected one of investigating, identified, monitoring, resolved.")
+	}
+	if message == "" {
+		problems = append(problems, "Missing required field 'message'.")
+	}
+	if *date == "" {
+		*date = time.Now().UTC().Format(time.RFC3339)
+	} else if _, err := time.Parse(time.RFC3339, *date); err != nil {
+		problems = append(problems, "Invalid date '"+*date+"', expected ISO 8601.")
+	}
+	return problems
+}
@@ -0,0 +1,77 @@
+package fakeapi
+
+import (
+	"sort"
+	"sync"
+)
+
+// Store holds the objects of one kind, keyed by id. It is safe for
+// concurrent use, so tests can inspect or change it while the server runs.
+type Store[T any] struct {
+	mu     sync.Mutex
+	lastId int64
+	items  map[int64]T
+}
+
+func newStore[T any]() *Store[T] {
+	return &Store[T]{items: map[int64]T{}}
+}
+
+// Get returns the object with the given id.
+func (s *Store[T]) Get(id int64) (T, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item, ok := s.items[id]
+	return item, ok
+}
+
+// Put stores item under id, replacing any existing object.
+func (s *Store[T]) Put(id int64, item T) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if id > s.lastId {
+		s.lastId = id
+	}
+	s.items[id] = item
+}
+
+// Delete removes the object with the given id, to simulate a change made
+// outside of the code under test.
+func (s *Store[T]) Delete(id int64) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, ok := s.items[id]
+	delete(s.items, id)
+	return ok
+}
+
+// List returns every object, sorted by id.
+func (s *Store[T]) List() []T {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	ids := make([]int64, 0, len(s.items))
+	for id := range s.items {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	items := make([]T, 0, len(ids))
+	for _, id := range ids {
+		items = append(items, s.items[id])
+	}
+	return items
+}
+
+// Len returns the number of objects.
+func (s *Store[T]) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.items)
+}
+
+// nextId reserves the id of a new object. Ids are never reused.
+func (s *Store[T]) nextId() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lastId++
+	return s.lastId
+}
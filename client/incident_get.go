@@ -0,0 +1,27 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// IncidentRequest is an incident opened when a monitor failed. Timestamps
+// are ISO 8601 and nil when not set.
+type IncidentRequest struct {
+	Id             int64   `json:"id"`
+	StartedAt      *string `json:"startedAt"`
+	MonitorId      *int64  `json:"monitorId"`
+	AcknowledgedAt *string `json:"acknowledgedAt"`
+	AcknowledgedBy *int64  `json:"acknowledgedBy"`
+	ResolvedAt     *string `json:"resolvedAt"`
+	ResolvedBy     *int64  `json:"resolvedBy"`
+}
+
+// GetIncident returns the incident with the given id.
+func (c *Client) GetIncident(ctx context.Context, id string) (*IncidentRequest, error) {
+	var incident IncidentRequest
+	if err := c.do(ctx, http.MethodGet, "incident/"+id, nil, &incident); err != nil {
+		return nil, err
+	}
+	return &incident, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListIncidents returns every incident of the workspace.
+func (c *Client) ListIncidents(ctx context.Context) ([]IncidentRequest, error) {
+	var incidents []IncidentRequest
+	if err := c.do(ctx, http.MethodGet, "incident", nil, &incidents); err != nil {
+		return nil, err
+	}
+	return incidents, nil
+}
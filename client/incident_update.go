@@ -0,0 +1,22 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// IncidentUpdateRequest sets when the incident was acknowledged and resolved,
+// a nil timestamp clears it.
+type IncidentUpdateRequest struct {
+	AcknowledgedAt *string `json:"acknowledgedAt"`
+	ResolvedAt     *string `json:"resolvedAt"`
+}
+
+// UpdateIncident acknowledges or resolves the incident with the given id.
+func (c *Client) UpdateIncident(ctx context.Context, request IncidentUpdateRequest, id string) (*IncidentRequest, error) {
+	var incident IncidentRequest
+	if err := c.do(ctx, http.MethodPut, "incident/"+id, &request, &incident); err != nil {
+		return nil, err
+	}
+	return &incident, nil
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+// Middleware wraps the transport of a Client, to decorate, observe or retry
+// the requests it sends.
+type Middleware func(next http.RoundTripper) http.RoundTripper
+
+// RoundTripFunc is an http.RoundTripper implemented by a function.
+type RoundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f RoundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// LogFunc receives the messages of the Logging and Retry middleware.
+type LogFunc func(ctx context.Context, msg string, fields map[string]interface{})
+
+// Auth sends token in the x-openstatus-key header. New always installs it.
+func Auth(token string) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			req = req.Clone(req.Context())
+			req.Header.Set("x-openstatus-key", token)
+			return next.RoundTrip(req)
+		})
+	}
+}
+
+// Logging reports every request with its status and duration.
+func Logging(log LogFunc) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next.RoundTrip(req)
+			fields := map[string]interface{}{
+				"method":   req.Method,
+				"url":      req.URL.String(),
+				"duration": time.Since(start).String(),
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+			} else {
+				fields["status"] = resp.StatusCode
+			}
+			log(req.Context(), "OpenStatus API request", fields)
+			return resp, err
+		})
+	}
+}
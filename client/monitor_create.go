@@ -2,45 +2,43 @@ package client
 
 import (
 	"context"
-	"encoding/json"
-
-	hreq "github.com/imroc/req/v3"
+	"net/http"
 )
 
+// MonitorRequest is a monitor, as sent to and returned by the API.
 type MonitorRequest struct {
-	Active      bool   `json:"active"`
-	Body        string `json:"body"`
-	Description string `json:"description"`
-	Headers     []struct {
-		Key   string `json:"key"`
-		Value string `json:"value"`
-	} `json:"headers,omitempty"`
-	Id            int64           `json:"id"`
-	Method        string          `json:"method"`
-	Name          string          `json:"name"`
-	Periodicity   string          `json:"periodicity"`
-	Regions       []string        `json:"regions"`
-	Url           string          `json:"url"`
-	Public        bool            `json:"public"`
-	Assertions    json.RawMessage `json:"assertions,omitempty"`
-	Timeout       int             `json:"timeout"`
-	DegradedAfter int             `json:"degradedAfter"`
-	Type          string          `json:"jobType,omitempty"`
+	Active        bool        `json:"active"`
+	Body          string      `json:"body"`
+	Description   string      `json:"description"`
+	Headers       []Header    `json:"headers,omitempty"`
+	Id            int64       `json:"id"`
+	Method        string      `json:"method"`
+	Name          string      `json:"name"`
+	Periodicity   string      `json:"periodicity"`
+	Regions       []string    `json:"regions"`
+	Url           string      `json:"url"`
+	Public        bool        `json:"public"`
+	Assertions    []Assertion `json:"assertions"`
+	Timeout       int         `json:"timeout"`
+	DegradedAfter int         `json:"degradedAfter"`
+	Type          string      `json:"jobType,omitempty"`
 }
 
-func CreateMonitor(ctx context.Context, c *hreq.Client, request MonitorRequest) (*MonitorRequest, error) {
-
-	response := c.Post("monitor").SetBody(&request).Do()
-
-	if response.Err != nil {
-		return nil, response.Err
+// DefaultMonitorTimeout is the timeout in milliseconds the API gives a
+// monitor created without one.
+const DefaultMonitorTimeout = 45000
 
-	}
+// Header is an http header sent by a monitor or a check.
+type Header struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
 
+// CreateMonitor creates a monitor and returns it with its id.
+func (c *Client) CreateMonitor(ctx context.Context, request MonitorRequest) (*MonitorRequest, error) {
 	var monitor MonitorRequest
-	if err := json.NewDecoder(response.Body).Decode(&monitor); err != nil {
+	if err := c.do(ctx, http.MethodPost, "monitor", &request, &monitor); err != nil {
 		return nil, err
 	}
-
 	return &monitor, nil
 }
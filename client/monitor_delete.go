@@ -2,18 +2,10 @@ package client
 
 import (
 	"context"
-
-	hreq "github.com/imroc/req/v3"
+	"net/http"
 )
 
-func DeleteMonitor(ctx context.Context, c *hreq.Client, id string) *error {
-
-	req := c.Delete("monitor/" + id).Do()
-
-	if req.Err != nil {
-		return &req.Err
-
-	}
-	return nil
-
+// DeleteMonitor deletes the monitor with the given id.
+func (c *Client) DeleteMonitor(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "monitor/"+id, nil, nil)
 }
@@ -2,22 +2,14 @@ package client
 
 import (
 	"context"
-	"encoding/json"
-
-	hreq "github.com/imroc/req/v3"
+	"net/http"
 )
 
-func GetMonitor(ctx context.Context, c *hreq.Client, id string) (*MonitorRequest, error) {
-
-	request := c.Get("monitor/" + id).Do()
-
-	if request.Err != nil {
-		return nil, request.Err
-	}
+// GetMonitor returns the monitor with the given id.
+func (c *Client) GetMonitor(ctx context.Context, id string) (*MonitorRequest, error) {
 	var monitor MonitorRequest
-	if err := json.NewDecoder(request.Body).Decode(&monitor); err != nil {
+	if err := c.do(ctx, http.MethodGet, "monitor/"+id, nil, &monitor); err != nil {
 		return nil, err
 	}
-
 	return &monitor, nil
 }
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListMonitors returns every monitor of the workspace.
+func (c *Client) ListMonitors(ctx context.Context) ([]MonitorRequest, error) {
+	var monitors []MonitorRequest
+	if err := c.do(ctx, http.MethodGet, "monitor", nil, &monitors); err != nil {
+		return nil, err
+	}
+	return monitors, nil
+}
@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// MonitorSummary holds the daily check counts of a monitor.
+type MonitorSummary struct {
+	Data []MonitorSummaryDay `json:"data"`
+}
+
+// MonitorSummaryDay counts the checks of one day and how many succeeded.
+type MonitorSummaryDay struct {
+	Ok    int64  `json:"ok"`
+	Count int64  `json:"count"`
+	Day   string `json:"day"`
+}
+
+// GetMonitorSummary returns the daily summary of the monitor with the
+// given id.
+func (c *Client) GetMonitorSummary(ctx context.Context, id string) (*MonitorSummary, error) {
+	var summary MonitorSummary
+	if err := c.do(ctx, http.MethodGet, "monitor/"+id+"/summary", nil, &summary); err != nil {
+		return nil, err
+	}
+	return &summary, nil
+}
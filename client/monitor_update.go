@@ -2,20 +2,13 @@ package client
 
 import (
 	"context"
-	"encoding/json"
-
-	hreq "github.com/imroc/req/v3"
+	"net/http"
 )
 
-func UpdateMonitor(ctx context.Context, c *hreq.Client, request MonitorRequest, id string) (*MonitorRequest, error) {
-
-	response := c.Put("monitor/" + id).SetBody(&request).Do()
-
-	if response.Err != nil {
-		return nil, response.Err
-	}
+// UpdateMonitor replaces the monitor with the given id.
+func (c *Client) UpdateMonitor(ctx context.Context, request MonitorRequest, id string) (*MonitorRequest, error) {
 	var monitor MonitorRequest
-	if err := json.NewDecoder(response.Body).Decode(&monitor); err != nil {
+	if err := c.do(ctx, http.MethodPut, "monitor/"+id, &request, &monitor); err != nil {
 		return nil, err
 	}
 	return &monitor, nil
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// NotificationRequest is a notification channel and the monitors it is
+// attached to.
+type NotificationRequest struct {
+	Id       int64               `json:"id"`
+	Name     string              `json:"name"`
+	Provider string              `json:"provider"`
+	Payload  NotificationPayload `json:"payload"`
+	Monitors []int64             `json:"monitors"`
+}
+
+// NotificationPayload carries the channel specific data, only the field
+// matching the notification provider is set.
+type NotificationPayload struct {
+	Email     string `json:"email,omitempty"`
+	Sms       string `json:"sms,omitempty"`
+	Slack     string `json:"slack,omitempty"`
+	Discord   string `json:"discord,omitempty"`
+	Pagerduty string `json:"pagerduty,omitempty"`
+}
+
+// CreateNotification creates a notification and returns it with its id.
+func (c *Client) CreateNotification(ctx context.Context, request NotificationRequest) (*NotificationRequest, error) {
+	var notification NotificationRequest
+	if err := c.do(ctx, http.MethodPost, "notification", &request, &notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
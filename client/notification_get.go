@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetNotification returns the notification with the given id.
+func (c *Client) GetNotification(ctx context.Context, id string) (*NotificationRequest, error) {
+	var notification NotificationRequest
+	if err := c.do(ctx, http.MethodGet, "notification/"+id, nil, &notification); err != nil {
+		return nil, err
+	}
+	return &notification, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListNotifications returns every notification of the workspace.
+func (c *Client) ListNotifications(ctx context.Context) ([]NotificationRequest, error) {
+	var notifications []NotificationRequest
+	if err := c.do(ctx, http.MethodGet, "notification", nil, &notifications); err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
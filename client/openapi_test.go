@@ -0,0 +1,202 @@
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// knownDeviations lists the documented properties the SDK does not map on
+// purpose, by Go type and property name.
+var knownDeviations = map[string]string{
+	"MonitorRequest.degratedAfter": "misspelled in the spec, the API reads and returns degradedAfter",
+	"CheckResponse.aggregated":     "the API only aggregates across regions, in CheckHttpResponse",
+}
+
+type openapiSchema struct {
+	Ref        string                    `json:"$ref"`
+	Type       string                    `json:"type"`
+	Properties map[string]*openapiSchema `json:"properties"`
+	Items      *openapiSchema            `json:"items"`
+	AnyOf      []*openapiSchema          `json:"anyOf"`
+}
+
+type openapiOperation struct {
+	RequestBody *struct {
+		Content map[string]struct {
+			Schema *openapiSchema `json:"schema"`
+		} `json:"content"`
+	} `json:"requestBody"`
+	Responses map[string]struct {
+		Content map[string]struct {
+			Schema *openapiSchema `json:"schema"`
+		} `json:"content"`
+	} `json:"responses"`
+}
+
+// TestOpenAPI checks that every property documented in openapi.json for an
+// endpoint is carried by the struct the SDK uses for it.
+func TestOpenAPI(t *testing.T) {
+	b, err := os.ReadFile("../openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec struct {
+		Paths map[string]map[string]openapiOperation `json:"paths"`
+	}
+	if err := json.Unmarshal(b, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	endpoints := []struct {
+		method, path      string
+		request, response interface{}
+	}{
+		{"get", "/incident", nil, []IncidentRequest{}},
+		{"get", "/incident/:id", nil, IncidentRequest{}},
+		{"put", "/incident/:id", IncidentUpdateRequest{}, IncidentRequest{}},
+		{"get", "/monitor", nil, []MonitorRequest{}},
+		{"post", "/monitor", MonitorRequest{}, MonitorRequest{}},
+		{"get", "/monitor/:id", nil, MonitorRequest{}},
+		{"put", "/monitor/:id", MonitorRequest{}, MonitorRequest{}},
+		{"delete", "/monitor/:id", nil, nil},
+		{"get", "/monitor/:id/summary", nil, MonitorSummary{}},
+		{"get", "/notification", nil, []NotificationRequest{}},
+		{"post", "/notification", NotificationRequest{}, NotificationRequest{}},
+		{"get", "/notification/:id", nil, NotificationRequest{}},
+		{"get", "/page", nil, []PageRequest{}},
+		{"post", "/page", PageRequest{}, PageRequest{}},
+		{"get", "/page/:id", nil, PageRequest{}},
+		{"put", "/page/:id", PageRequest{}, PageRequest{}},
+		{"post", "/page_subscriber/:id/update", PageSubscriberRequest{}, PageSubscriberRequest{}},
+		{"get", "/status_report", nil, []StatusReportRequest{}},
+		{"post", "/status_report", StatusReportRequest{}, StatusReportRequest{}},
+		{"get", "/status_report/:id", nil, StatusReportRequest{}},
+		{"delete", "/status_report/:id", nil, nil},
+		{"post", "/status_report/:id/update", StatusReportUpdateRequest{}, StatusReportRequest{}},
+		{"get", "/status_report_update/:id", nil, StatusReportUpdateRequest{}},
+		{"post", "/status_report_update", StatusReportUpdateRequest{}, StatusReportUpdateRequest{}},
+		{"post", "/check/http", CheckHttpRequest{}, CheckHttpResponse{}},
+	}
+
+	covered := map[string]bool{}
+	for _, endpoint := range endpoints {
+		name := strings.ToUpper(endpoint.method) + " " + endpoint.path
+		covered[name] = true
+		operation, ok := spec.Paths[endpoint.path][endpoint.method]
+		if !ok {
+			t.Errorf("%s is not documented", name)
+			continue
+		}
+		if operation.RequestBody != nil && endpoint.request != nil {
+			compareSchema(t, name+" request", operation.RequestBody.Content["application/json"].Schema, reflect.TypeOf(endpoint.request))
+		}
+		if response, ok := operation.Responses["200"]; ok && endpoint.response != nil {
+			compareSchema(t, name+" response", response.Content["application/json"].Schema, reflect.TypeOf(endpoint.response))
+		}
+	}
+
+	for path, operations := range spec.Paths {
+		for method := range operations {
+			if name := strings.ToUpper(method) + " " + path; !covered[name] {
+				t.Errorf("%s has no method in the SDK", name)
+			}
+		}
+	}
+}
+
+func compareSchema(t *testing.T, where string, schema *openapiSchema, typ reflect.Type) {
+	t.Helper()
+	if schema == nil {
+		return
+	}
+	for typ.Kind() == reflect.Slice || typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+		if schema.Items != nil {
+			schema = schema.Items
+		}
+	}
+	if typ.Kind() != reflect.Struct || typ == reflect.TypeOf(json.RawMessage{}) {
+		return
+	}
+	// Types encoding themselves, such as Assertion, are tested on their own.
+	if reflect.PointerTo(typ).Implements(reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()) {
+		return
+	}
+
+	properties := schema.Properties
+	// Payloads documented as anyOf objects are merged into a single struct.
+	for _, alternative := range schema.AnyOf {
+		for name, property := range alternative.Properties {
+			if properties == nil {
+				properties = map[string]*openapiSchema{}
+			}
+			properties[name] = property
+		}
+	}
+
+	fields := map[string]reflect.StructField{}
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		fields[name] = field
+	}
+
+	for name, property := range properties {
+		if _, ok := knownDeviations[typ.Name()+"."+name]; ok {
+			continue
+		}
+		field, ok := fields[name]
+		if !ok {
+			t.Errorf("%s: %s.%s is documented but not mapped", where, typ.Name(), name)
+			continue
+		}
+		compareSchema(t, where, property, field.Type)
+	}
+}
+
+// TestOpenAPIRegions checks that Regions holds the region codes enumerated by
+// openapi.json.
+func TestOpenAPIRegions(t *testing.T) {
+	b, err := os.ReadFile("../openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var spec interface{}
+	if err := json.Unmarshal(b, &spec); err != nil {
+		t.Fatal(err)
+	}
+
+	found := 0
+	var walk func(value interface{})
+	walk = func(value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			if enum, ok := v["enum"].([]interface{}); ok && len(enum) > 0 && enum[0] == "ams" {
+				found++
+				var codes []string
+				for _, code := range enum {
+					codes = append(codes, code.(string))
+				}
+				slices.Sort(codes)
+				if !reflect.DeepEqual(codes, RegionCodes()) {
+					t.Errorf("openapi.json enumerates the regions %v, Regions has %v", codes, RegionCodes())
+				}
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []interface{}:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(spec)
+	if found == 0 {
+		t.Error("no region enum found in openapi.json")
+	}
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// PageRequest is a status page.
+type PageRequest struct {
+	Id                int64        `json:"id"`
+	Title             string       `json:"title"`
+	Description       string       `json:"description"`
+	Slug              string       `json:"slug"`
+	CustomDomain      string       `json:"customDomain"`
+	Icon              string       `json:"icon"`
+	PasswordProtected bool         `json:"passwordProtected"`
+	Password          string       `json:"password,omitempty"`
+	Monitors          PageMonitors `json:"monitors"`
+}
+
+// PageMonitors holds the ids of the monitors shown on a page. The API accepts
+// and may return either a list of ids or a list of {monitorId, order} objects.
+type PageMonitors []int64
+
+func (m *PageMonitors) UnmarshalJSON(b []byte) error {
+	var ids []int64
+	if err := json.Unmarshal(b, &ids); err == nil {
+		*m = ids
+		return nil
+	}
+
+	var ordered []struct {
+		MonitorId int64 `json:"monitorId"`
+		Order     int64 `json:"order"`
+	}
+	if err := json.Unmarshal(b, &ordered); err != nil {
+		return err
+	}
+	ids = make([]int64, len(ordered))
+	for i, o := range ordered {
+		ids[i] = o.MonitorId
+	}
+	*m = ids
+	return nil
+}
+
+// CreatePage creates a status page and returns it with its id.
+func (c *Client) CreatePage(ctx context.Context, request PageRequest) (*PageRequest, error) {
+	var page PageRequest
+	if err := c.do(ctx, http.MethodPost, "page", &request, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetPage returns the status page with the given id.
+func (c *Client) GetPage(ctx context.Context, id string) (*PageRequest, error) {
+	var page PageRequest
+	if err := c.do(ctx, http.MethodGet, "page/"+id, nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListPages returns every status page of the workspace.
+func (c *Client) ListPages(ctx context.Context) ([]PageRequest, error) {
+	var pages []PageRequest
+	if err := c.do(ctx, http.MethodGet, "page", nil, &pages); err != nil {
+		return nil, err
+	}
+	return pages, nil
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// PageSubscriberRequest is an email subscribed to a status page.
+type PageSubscriberRequest struct {
+	Email string `json:"email"`
+}
+
+// UpdatePageSubscriber subscribes the email to the updates of the page.
+func (c *Client) UpdatePageSubscriber(ctx context.Context, request PageSubscriberRequest, pageId string) (*PageSubscriberRequest, error) {
+	var subscriber PageSubscriberRequest
+	if err := c.do(ctx, http.MethodPost, "page_subscriber/"+pageId+"/update", &request, &subscriber); err != nil {
+		return nil, err
+	}
+	return &subscriber, nil
+}
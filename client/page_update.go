@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// UpdatePage updates the status page with the given id.
+func (c *Client) UpdatePage(ctx context.Context, request PageRequest, id string) (*PageRequest, error) {
+	var page PageRequest
+	if err := c.do(ctx, http.MethodPut, "page/"+id, &request, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
@@ -0,0 +1,67 @@
+package client
+
+// Region is a location OpenStatus runs checks from.
+type Region struct {
+	Code      string
+	City      string
+	Country   string
+	Continent string
+}
+
+// Continents of the regions.
+const (
+	Africa       = "Africa"
+	Asia         = "Asia"
+	Europe       = "Europe"
+	NorthAmerica = "North America"
+	Oceania      = "Oceania"
+	SouthAmerica = "South America"
+)
+
+// Regions lists every region accepted by the API, sorted by code.
+var Regions = []Region{
+	{"ams", "Amsterdam", "Netherlands", Europe},
+	{"arn", "Stockholm", "Sweden", Europe},
+	{"atl", "Atlanta", "United States", NorthAmerica},
+	{"bog", "Bogotá", "Colombia", SouthAmerica},
+	{"bom", "Mumbai", "India", Asia},
+	{"bos", "Boston", "United States", NorthAmerica},
+	{"cdg", "Paris", "France", Europe},
+	{"den", "Denver", "United States", NorthAmerica},
+	{"dfw", "Dallas", "United States", NorthAmerica},
+	{"ewr", "Secaucus", "United States", NorthAmerica},
+	{"eze", "Buenos Aires", "Argentina", SouthAmerica},
+	{"fra", "Frankfurt", "Germany", Europe},
+	{"gdl", "Guadalajara", "Mexico", NorthAmerica},
+	{"gig", "Rio de Janeiro", "Brazil", SouthAmerica},
+	{"gru", "São Paulo", "Brazil", SouthAmerica},
+	{"hkg", "Hong Kong", "Hong Kong", Asia},
+	{"iad", "Ashburn", "United States", NorthAmerica},
+	{"jnb", "Johannesburg", "South Africa", Africa},
+	{"lax", "Los Angeles", "United States", NorthAmerica},
+	{"lhr", "London", "United Kingdom", Europe},
+	{"mad", "Madrid", "Spain", Europe},
+	{"mia", "Miami", "United States", NorthAmerica},
+	{"nrt", "Tokyo", "Japan", Asia},
+	{"ord", "Chicago", "United States", NorthAmerica},
+	{"otp", "Bucharest", "Romania", Europe},
+	{"phx", "Phoenix", "United States", NorthAmerica},
+	{"qro", "Querétaro", "Mexico", NorthAmerica},
+	{"scl", "Santiago", "Chile", SouthAmerica},
+	{"sea", "Seattle", "United States", NorthAmerica},
+	{"sin", "Singapore", "Singapore", Asia},
+	{"sjc", "San Jose", "United States", NorthAmerica},
+	{"syd", "Sydney", "Australia", Oceania},
+	{"waw", "Warsaw", "Poland", Europe},
+	{"yul", "Montreal", "Canada", NorthAmerica},
+	{"yyz", "Toronto", "Canada", NorthAmerica},
+}
+
+// RegionCodes returns the code of every region, sorted.
+func RegionCodes() []string {
+	codes := make([]string, 0, len(Regions))
+	for _, region := range Regions {
+		codes = append(codes, region.Code)
+	}
+	return codes
+}
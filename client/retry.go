@@ -0,0 +1,151 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy describes how transient failures of the API are retried.
+// Idempotent calls are retried on network errors and 5xx responses, every
+// call is retried on 429.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, the first one included.
+	MaxAttempts int
+	// BaseBackoff is the wait before the first retry, doubled on each retry.
+	BaseBackoff time.Duration
+	// MaxBackoff caps the exponential backoff. A Retry-After header sent by
+	// the API takes precedence.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is used when the provider does not configure retries.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseBackoff: time.Second,
+	MaxBackoff:  30 * time.Second,
+}
+
+// Retry retries the requests failing transiently according to policy,
+// reporting every retry to log when not nil. Waiting stops as soon as the
+// context of the request is done.
+func Retry(policy RetryPolicy, log LogFunc) Middleware {
+	return func(next http.RoundTripper) http.RoundTripper {
+		return RoundTripFunc(func(req *http.Request) (*http.Response, error) {
+			for attempt := 1; ; attempt++ {
+				resp, err := next.RoundTrip(req)
+				if attempt >= policy.MaxAttempts || !shouldRetry(req, resp, err) {
+					return resp, err
+				}
+				// The body cannot be sent again.
+				if req.Body != nil && req.GetBody == nil {
+					return resp, err
+				}
+
+				wait := policy.interval(resp, attempt)
+				if log != nil {
+					fields := map[string]interface{}{
+						"method":  req.Method,
+						"url":     req.URL.String(),
+						"attempt": attempt + 1,
+						"wait":    wait.String(),
+					}
+					if err != nil {
+						fields["error"] = err.Error()
+					} else {
+						fields["status"] = resp.StatusCode
+					}
+					log(req.Context(), "Retrying OpenStatus API request", fields)
+				}
+				if resp != nil {
+					_, _ = io.Copy(io.Discard, resp.Body)
+					resp.Body.Close()
+				}
+
+				timer := time.NewTimer(wait)
+				select {
+				case <-req.Context().Done():
+					timer.Stop()
+					return nil, req.Context().Err()
+				case <-timer.C:
+				}
+
+				if req.GetBody != nil {
+					body, err := req.GetBody()
+					if err != nil {
+						return nil, err
+					}
+					req = req.Clone(req.Context())
+					req.Body = body
+				}
+			}
+		})
+	}
+}
+
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil || errors.Is(err, ErrNoInteraction) {
+		return false
+	}
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		return true
+	}
+	if !idempotent(req.Method) {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// interval honors Retry-After, either in seconds or as an HTTP date, and
+// falls back to an exponential backoff with jitter.
+func (p RetryPolicy) interval(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if wait, ok := retryAfter(resp.Header.Get("Retry-After")); ok {
+			return wait
+		}
+	}
+
+	backoff := math.Min(float64(p.MaxBackoff), float64(p.BaseBackoff)*math.Exp2(float64(attempt-1)))
+	if backoff <= 0 {
+		return 0
+	}
+	half := int64(backoff / 2)
+	return time.Duration(half + rand.Int63n(half+1))
+}
+
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
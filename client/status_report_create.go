@@ -0,0 +1,28 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// StatusReportRequest is a status report. Message and Date are only sent
+// on creation, they become the first update of the report.
+type StatusReportRequest struct {
+	Id                    int64   `json:"id"`
+	Title                 string  `json:"title"`
+	Status                string  `json:"status"`
+	Message               string  `json:"message,omitempty"`
+	Date                  string  `json:"date,omitempty"`
+	MonitorIds            []int64 `json:"monitorIds"`
+	PageId                int64   `json:"pageId,omitempty"`
+	StatusReportUpdateIds []int64 `json:"statusReportUpdateIds,omitempty"`
+}
+
+// CreateStatusReport creates a status report and returns it with its id.
+func (c *Client) CreateStatusReport(ctx context.Context, request StatusReportRequest) (*StatusReportRequest, error) {
+	var report StatusReportRequest
+	if err := c.do(ctx, http.MethodPost, "status_report", &request, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
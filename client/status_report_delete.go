@@ -0,0 +1,11 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// DeleteStatusReport deletes the status report with the given id.
+func (c *Client) DeleteStatusReport(ctx context.Context, id string) error {
+	return c.do(ctx, http.MethodDelete, "status_report/"+id, nil, nil)
+}
@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// GetStatusReport returns the status report with the given id.
+func (c *Client) GetStatusReport(ctx context.Context, id string) (*StatusReportRequest, error) {
+	var report StatusReportRequest
+	if err := c.do(ctx, http.MethodGet, "status_report/"+id, nil, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
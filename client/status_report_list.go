@@ -0,0 +1,15 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// ListStatusReports returns every status report of the workspace.
+func (c *Client) ListStatusReports(ctx context.Context) ([]StatusReportRequest, error) {
+	var reports []StatusReportRequest
+	if err := c.do(ctx, http.MethodGet, "status_report", nil, &reports); err != nil {
+		return nil, err
+	}
+	return reports, nil
+}
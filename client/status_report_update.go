@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+)
+
+// StatusReportUpdateRequest is one entry of the history of a status report.
+type StatusReportUpdateRequest struct {
+	// Id is documented as a string but the report lists update ids as
+	// numbers, json.Number accepts both.
+	Id             json.Number `json:"id,omitempty"`
+	Status         string      `json:"status"`
+	Date           string      `json:"date"`
+	Message        string      `json:"message"`
+	StatusReportId int64       `json:"statusReportId"`
+}
+
+// UpdateStatusReport posts a new update to the status report, changing its
+// current status, and returns the updated report.
+func (c *Client) UpdateStatusReport(ctx context.Context, request StatusReportUpdateRequest, id string) (*StatusReportRequest, error) {
+	var report StatusReportRequest
+	if err := c.do(ctx, http.MethodPost, "status_report/"+id+"/update", &request, &report); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// GetStatusReportUpdate returns the status report update with the given id.
+func (c *Client) GetStatusReportUpdate(ctx context.Context, id string) (*StatusReportUpdateRequest, error) {
+	var update StatusReportUpdateRequest
+	if err := c.do(ctx, http.MethodGet, "status_report_update/"+id, nil, &update); err != nil {
+		return nil, err
+	}
+	return &update, nil
+}
+
+// CreateStatusReportUpdate adds an update to the report named by
+// request.StatusReportId.
+func (c *Client) CreateStatusReportUpdate(ctx context.Context, request StatusReportUpdateRequest) (*StatusReportUpdateRequest, error) {
+	var update StatusReportUpdateRequest
+	if err := c.do(ctx, http.MethodPost, "status_report_update", &request, &update); err != nil {
+		return nil, err
+	}
+	return &update, nil
+}
@@ -15,31 +15,52 @@ resource "openstatus_monitor" "my_monitor" {
   regions        = ["iad", "jnb", "ams"]
   periodicity    = "10m"
   name           = "test-monitor"
-  degraded_after = 30
-  timeout        = 13
+  degraded_after = 13
+  timeout        = 30
   active         = false
   description    = "This is a test monitor"
   headers = [
     { key = "test-key", value = "test-value" },
   ]
-  assertions = [
-    {
-      type    = "status"
-      target  = "200"
-      key     = ""
-      compare = "eq"
-    },
-    {
-      type    = "status"
-      target  = "300"
-      key     = ""
-      compare = "eq"
-    },
-    {
-      type    = "header"
-      target  = "test"
-      key     = "test"
-      compare = "eq"
-    }
+  status_assertions = [
+    { compare = "gte", target = 200 },
+    { compare = "lt", target = 300 },
+  ]
+  header_assertions = [
+    { key = "test", compare = "eq", target = "test" },
+  ]
+  json_body_assertions = [
+    { path = "$.status", compare = "eq", target = "ok" },
+  ]
+}
+
+resource "openstatus_status_page" "my_page" {
+  title       = "My status page"
+  slug        = "my-status-page"
+  description = "The status of our services"
+  monitors    = [openstatus_monitor.my_monitor.id]
+}
+
+resource "openstatus_notification" "on_call" {
+  name     = "On-call email"
+  monitors = [openstatus_monitor.my_monitor.id]
+  email {
+    address = "oncall@example.com"
+  }
+}
+
+resource "openstatus_status_report" "outage" {
+  title       = "Degraded performance"
+  status      = "investigating"
+  message     = "We are looking into slow responses."
+  page_id     = openstatus_status_page.my_page.id
+  monitor_ids = [openstatus_monitor.my_monitor.id]
+}
+
+resource "openstatus_page_subscribers" "stakeholders" {
+  page_id = openstatus_status_page.my_page.id
+  emails = [
+    "cto@example.com",
+    "support@example.com",
   ]
 }
@@ -0,0 +1,232 @@
+package datasource_http_check
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var urlRegexp = regexp.MustCompile(`^https?://\S+$`)
+
+func HttpCheckDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aggregated": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to aggregate the results",
+				MarkdownDescription: "Whether to aggregate the results",
+			},
+			"aggregated_timing": schema.SingleNestedAttribute{
+				Attributes: map[string]schema.Attribute{
+					"connect":    percentilesAttribute("The aggregated connect timing"),
+					"dns":        percentilesAttribute("The aggregated DNS timing"),
+					"first_byte": percentilesAttribute("The aggregated first byte timing"),
+					"latency":    percentilesAttribute("The aggregated latency"),
+					"tls":        percentilesAttribute("The aggregated TLS handshake timing"),
+					"transfer":   percentilesAttribute("The aggregated transfer timing"),
+				},
+				Computed:            true,
+				Description:         "The aggregated timings in milliseconds, set when aggregated is true",
+				MarkdownDescription: "The aggregated timings in milliseconds, set when `aggregated` is true",
+			},
+			"body": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The body",
+				MarkdownDescription: "The body",
+			},
+			"headers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"key": schema.StringAttribute{
+							Required: true,
+						},
+						"value": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Optional:            true,
+				Description:         "The headers of your request",
+				MarkdownDescription: "The headers of your request",
+			},
+			"id": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The id of the check",
+				MarkdownDescription: "The id of the check",
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"GET",
+						"POST",
+						"HEAD",
+					),
+				},
+			},
+			"regions": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Where we should run the check",
+				MarkdownDescription: "Where we should run the check",
+			},
+			"responses": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"body": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The body of the response",
+							MarkdownDescription: "The body of the response",
+						},
+						"headers": schema.MapAttribute{
+							ElementType:         types.StringType,
+							Computed:            true,
+							Description:         "The headers of the response",
+							MarkdownDescription: "The headers of the response",
+						},
+						"latency": schema.Float64Attribute{
+							Computed:            true,
+							Description:         "The latency of the response in milliseconds",
+							MarkdownDescription: "The latency of the response in milliseconds",
+						},
+						"region": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The region where the check ran",
+							MarkdownDescription: "The region where the check ran",
+						},
+						"status": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The status code of the response",
+							MarkdownDescription: "The status code of the response",
+						},
+						"timestamp": schema.Float64Attribute{
+							Computed:            true,
+							Description:         "The timestamp of the response in UTC",
+							MarkdownDescription: "The timestamp of the response in UTC",
+						},
+						"timing": timingAttribute("The timings of the response in milliseconds"),
+					},
+				},
+				Computed:            true,
+				Description:         "The last response of every region",
+				MarkdownDescription: "The last response of every region",
+			},
+			"run_count": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of times to run the check",
+				MarkdownDescription: "The number of times to run the check",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 5),
+				},
+			},
+			"timings": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: timingAttribute("").Attributes,
+				},
+				Computed:            true,
+				Description:         "The timings in milliseconds of every run",
+				MarkdownDescription: "The timings in milliseconds of every run",
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				Description:         "The url to check",
+				MarkdownDescription: "The url to check",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(urlRegexp, "must be an http(s) url"),
+				},
+			},
+		},
+	}
+}
+
+func percentilesAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"max": schema.Float64Attribute{Computed: true, Description: "The maximum value", MarkdownDescription: "The maximum value"},
+			"min": schema.Float64Attribute{Computed: true, Description: "The minimum value", MarkdownDescription: "The minimum value"},
+			"p50": schema.Float64Attribute{Computed: true, Description: "The 50th percentile", MarkdownDescription: "The 50th percentile"},
+			"p75": schema.Float64Attribute{Computed: true, Description: "The 75th percentile", MarkdownDescription: "The 75th percentile"},
+			"p95": schema.Float64Attribute{Computed: true, Description: "The 95th percentile", MarkdownDescription: "The 95th percentile"},
+			"p99": schema.Float64Attribute{Computed: true, Description: "The 99th percentile", MarkdownDescription: "The 99th percentile"},
+		},
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
+
+func timingAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"connect":    schema.Float64Attribute{Computed: true, Description: "The connect duration", MarkdownDescription: "The connect duration"},
+			"dns":        schema.Float64Attribute{Computed: true, Description: "The DNS lookup duration", MarkdownDescription: "The DNS lookup duration"},
+			"first_byte": schema.Float64Attribute{Computed: true, Description: "The time to first byte", MarkdownDescription: "The time to first byte"},
+			"tls":        schema.Float64Attribute{Computed: true, Description: "The TLS handshake duration", MarkdownDescription: "The TLS handshake duration"},
+			"transfer":   schema.Float64Attribute{Computed: true, Description: "The transfer duration", MarkdownDescription: "The transfer duration"},
+		},
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
+
+type HttpCheckModel struct {
+	Aggregated       types.Bool       `tfsdk:"aggregated"`
+	AggregatedTiming *AggregatedModel `tfsdk:"aggregated_timing"`
+	Body             types.String     `tfsdk:"body"`
+	Headers          types.List       `tfsdk:"headers"`
+	Id               types.Number     `tfsdk:"id"`
+	Method           types.String     `tfsdk:"method"`
+	Regions          types.List       `tfsdk:"regions"`
+	Responses        []ResponsesModel `tfsdk:"responses"`
+	RunCount         types.Int64      `tfsdk:"run_count"`
+	Timings          []TimingModel    `tfsdk:"timings"`
+	Url              types.String     `tfsdk:"url"`
+}
+
+type HeadersModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+type ResponsesModel struct {
+	Body      types.String  `tfsdk:"body"`
+	Headers   types.Map     `tfsdk:"headers"`
+	Latency   types.Float64 `tfsdk:"latency"`
+	Region    types.String  `tfsdk:"region"`
+	Status    types.Int64   `tfsdk:"status"`
+	Timestamp types.Float64 `tfsdk:"timestamp"`
+	Timing    TimingModel   `tfsdk:"timing"`
+}
+
+type TimingModel struct {
+	Connect   types.Float64 `tfsdk:"connect"`
+	Dns       types.Float64 `tfsdk:"dns"`
+	FirstByte types.Float64 `tfsdk:"first_byte"`
+	Tls       types.Float64 `tfsdk:"tls"`
+	Transfer  types.Float64 `tfsdk:"transfer"`
+}
+
+type AggregatedModel struct {
+	Connect   PercentilesModel `tfsdk:"connect"`
+	Dns       PercentilesModel `tfsdk:"dns"`
+	FirstByte PercentilesModel `tfsdk:"first_byte"`
+	Latency   PercentilesModel `tfsdk:"latency"`
+	Tls       PercentilesModel `tfsdk:"tls"`
+	Transfer  PercentilesModel `tfsdk:"transfer"`
+}
+
+type PercentilesModel struct {
+	Max types.Float64 `tfsdk:"max"`
+	Min types.Float64 `tfsdk:"min"`
+	P50 types.Float64 `tfsdk:"p50"`
+	P75 types.Float64 `tfsdk:"p75"`
+	P95 types.Float64 `tfsdk:"p95"`
+	P99 types.Float64 `tfsdk:"p99"`
+}
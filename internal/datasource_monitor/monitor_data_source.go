@@ -0,0 +1,199 @@
+package datasource_monitor
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func MonitorDataSourceSchema(ctx context.Context) schema.Schema {
+	attributes := monitorAttributes(ctx)
+	attributes["id"] = schema.NumberAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "The id of the monitor to look up",
+		MarkdownDescription: "The id of the monitor to look up",
+	}
+	attributes["name"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "The name of the monitor to look up",
+		MarkdownDescription: "The name of the monitor to look up",
+	}
+	attributes["url"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "The url of the monitor to look up",
+		MarkdownDescription: "The url of the monitor to look up",
+	}
+
+	return schema.Schema{
+		Attributes: attributes,
+	}
+}
+
+// monitorAttributes describes a monitor as read from the API, with the
+// assertions sharing the element types of the monitor resource.
+func monitorAttributes(ctx context.Context) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"active": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "If the monitor is active",
+			MarkdownDescription: "If the monitor is active",
+		},
+		"body": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The body",
+			MarkdownDescription: "The body",
+		},
+		"degraded_after": schema.NumberAttribute{
+			Computed:            true,
+			Description:         "The time after the monitor is considered degraded",
+			MarkdownDescription: "The time after the monitor is considered degraded",
+		},
+		"description": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The description of your monitor",
+			MarkdownDescription: "The description of your monitor",
+		},
+		"headers": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"key": schema.StringAttribute{
+						Computed: true,
+					},
+					"value": schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			},
+			Computed:            true,
+			Description:         "The headers of your request",
+			MarkdownDescription: "The headers of your request",
+		},
+		"id": schema.NumberAttribute{
+			Computed:            true,
+			Description:         "The id of the monitor",
+			MarkdownDescription: "The id of the monitor",
+		},
+		"method": schema.StringAttribute{
+			Computed: true,
+		},
+		"name": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The name of the monitor",
+			MarkdownDescription: "The name of the monitor",
+		},
+		"periodicity": schema.StringAttribute{
+			Computed:            true,
+			Description:         "How often the monitor should run",
+			MarkdownDescription: "How often the monitor should run",
+		},
+		"public": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "If the monitor is public",
+			MarkdownDescription: "If the monitor is public",
+		},
+		"regions": schema.ListAttribute{
+			ElementType:         types.StringType,
+			Computed:            true,
+			Description:         "The codes of the regions the monitor runs from",
+			MarkdownDescription: "The codes of the regions the monitor runs from",
+		},
+		"timeout": schema.NumberAttribute{
+			Computed:            true,
+			Description:         "The timeout of the request",
+			MarkdownDescription: "The timeout of the request",
+		},
+		"url": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The url to monitor",
+			MarkdownDescription: "The url to monitor",
+		},
+		"type": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The type of the monitor",
+			MarkdownDescription: "The type of the monitor",
+		},
+	}
+
+	attributes["status_assertions"] = assertionList("Assertions on the status code of the response", map[string]schema.Attribute{
+		"compare": computedString("The comparison to run"),
+		"target": schema.Int64Attribute{
+			Computed:            true,
+			Description:         "The expected status code",
+			MarkdownDescription: "The expected status code",
+		},
+	})
+	attributes["header_assertions"] = assertionList("Assertions on a header of the response", map[string]schema.Attribute{
+		"key":     computedString("The name of the header to check"),
+		"compare": computedString("The comparison to run"),
+		"target":  computedString("The target value"),
+	})
+	attributes["text_body_assertions"] = assertionList("Assertions on the body of the response", map[string]schema.Attribute{
+		"compare": computedString("The comparison to run"),
+		"target":  computedString("The target value"),
+	})
+	attributes["json_body_assertions"] = assertionList("Assertions on a value of the JSON body of the response", map[string]schema.Attribute{
+		"path":    computedString("The JSONPath of the value to check"),
+		"compare": computedString("The comparison to run"),
+		"target":  computedString("The target value"),
+	})
+	return attributes
+}
+
+func assertionList(description string, attributes map[string]schema.Attribute) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
+
+func computedString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
+
+// MonitorModel is a monitor read by the openstatus_monitor data source, and
+// an element of the monitors list of openstatus_monitors.
+type MonitorModel struct {
+	Active             types.Bool   `tfsdk:"active"`
+	Body               types.String `tfsdk:"body"`
+	DegradedAfter      types.Number `tfsdk:"degraded_after"`
+	Description        types.String `tfsdk:"description"`
+	Headers            types.List   `tfsdk:"headers"`
+	Id                 types.Number `tfsdk:"id"`
+	Method             types.String `tfsdk:"method"`
+	Name               types.String `tfsdk:"name"`
+	Periodicity        types.String `tfsdk:"periodicity"`
+	Public             types.Bool   `tfsdk:"public"`
+	Regions            types.List   `tfsdk:"regions"`
+	Timeout            types.Number `tfsdk:"timeout"`
+	Url                types.String `tfsdk:"url"`
+	Type               types.String `tfsdk:"type"`
+	StatusAssertions   types.List   `tfsdk:"status_assertions"`
+	HeaderAssertions   types.List   `tfsdk:"header_assertions"`
+	TextBodyAssertions types.List   `tfsdk:"text_body_assertions"`
+	JsonBodyAssertions types.List   `tfsdk:"json_body_assertions"`
+}
+
+type HeaderModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
+
+// HeaderType is the element type of the headers list.
+var HeaderType = types.ObjectType{AttrTypes: map[string]attr.Type{
+	"key":   types.StringType,
+	"value": types.StringType,
+}}
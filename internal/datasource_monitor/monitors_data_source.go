@@ -0,0 +1,76 @@
+package datasource_monitor
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func MonitorsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Only return active or inactive monitors",
+				MarkdownDescription: "Only return active or inactive monitors",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.NumberType,
+				Computed:            true,
+				Description:         "The ids of the matching monitors",
+				MarkdownDescription: "The ids of the matching monitors",
+			},
+			"monitors": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: monitorAttributes(ctx),
+				},
+				Computed:            true,
+				Description:         "The matching monitors",
+				MarkdownDescription: "The matching monitors",
+			},
+			"name_regex": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return monitors whose name matches this regular expression",
+				MarkdownDescription: "Only return monitors whose name matches this regular expression",
+			},
+			"public": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Only return public or private monitors",
+				MarkdownDescription: "Only return public or private monitors",
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return monitors running in this region",
+				MarkdownDescription: "Only return monitors running in this region",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return monitors of this type",
+				MarkdownDescription: "Only return monitors of this type",
+				Validators: []validator.String{stringvalidator.OneOf(
+					"http", "tcp",
+				)},
+			},
+		},
+	}
+}
+
+type MonitorsModel struct {
+	Active    types.Bool   `tfsdk:"active"`
+	Ids       types.List   `tfsdk:"ids"`
+	Monitors  types.List   `tfsdk:"monitors"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Public    types.Bool   `tfsdk:"public"`
+	Region    types.String `tfsdk:"region"`
+	Type      types.String `tfsdk:"type"`
+}
+
+// MonitorsType is the element type of the monitors list.
+func MonitorsType(ctx context.Context) attr.Type {
+	return MonitorsDataSourceSchema(ctx).Attributes["monitors"].(schema.ListNestedAttribute).NestedObject.Type()
+}
@@ -0,0 +1,110 @@
+package datasource_monitor_summary
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func MonitorSummaryDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"days": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"count": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The total number of requests",
+							MarkdownDescription: "The total number of requests",
+						},
+						"day": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The day of the stats",
+							MarkdownDescription: "The day of the stats",
+						},
+						"ok": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The number of ok responses",
+							MarkdownDescription: "The number of ok responses",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The daily stats, most recent first",
+				MarkdownDescription: "The daily stats, most recent first",
+			},
+			"error_budget_remaining": schema.Float64Attribute{
+				Computed:            true,
+				Description:         "The percentage of the error budget of slo_target left over the last slo_window calendar days, negative once overspent. Null, with a warning, when no check ran or when a 100% target has failed checks",
+				MarkdownDescription: "The percentage of the error budget of `slo_target` left over the last `slo_window` calendar days, negative once overspent. Null, with a warning, when no check ran or when a `100` target has failed checks",
+			},
+			"monitor_id": schema.NumberAttribute{
+				Required:            true,
+				Description:         "The id of the monitor",
+				MarkdownDescription: "The id of the monitor",
+			},
+			"slo_target": schema.Float64Attribute{
+				Optional:            true,
+				Description:         "The availability objective in percent, e.g. 99.9",
+				MarkdownDescription: "The availability objective in percent, e.g. `99.9`",
+				Validators: []validator.Float64{
+					float64validator.Between(0, 100),
+				},
+			},
+			"slo_window": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The number of calendar days, ending today in UTC, the error budget is computed over, defaults to 30",
+				MarkdownDescription: "The number of calendar days, ending today in UTC, the error budget is computed over, defaults to `30`",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"uptime": schema.MapAttribute{
+				ElementType:         types.Float64Type,
+				Computed:            true,
+				Description:         "The uptime percentage keyed by window in days, null when the window has no checks",
+				MarkdownDescription: "The uptime percentage keyed by window in days, null when the window has no checks",
+			},
+			"windows": schema.ListAttribute{
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Description:         "The windows in calendar days, ending today in UTC, to compute the uptime over, defaults to 7, 30 and 90",
+				MarkdownDescription: "The windows in calendar days, ending today in UTC, to compute the uptime over, defaults to `[7, 30, 90]`",
+				Validators: []validator.List{
+					listvalidator.ValueInt64sAre(int64validator.AtLeast(1)),
+				},
+			},
+		},
+	}
+}
+
+type MonitorSummaryModel struct {
+	Days                 types.List    `tfsdk:"days"`
+	ErrorBudgetRemaining types.Float64 `tfsdk:"error_budget_remaining"`
+	MonitorId            types.Number  `tfsdk:"monitor_id"`
+	SloTarget            types.Float64 `tfsdk:"slo_target"`
+	SloWindow            types.Int64   `tfsdk:"slo_window"`
+	Uptime               types.Map     `tfsdk:"uptime"`
+	Windows              types.List    `tfsdk:"windows"`
+}
+
+type DaysModel struct {
+	Count types.Int64  `tfsdk:"count"`
+	Day   types.String `tfsdk:"day"`
+	Ok    types.Int64  `tfsdk:"ok"`
+}
+
+func (m DaysModel) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"count": types.Int64Type,
+		"day":   types.StringType,
+		"ok":    types.Int64Type,
+	}
+}
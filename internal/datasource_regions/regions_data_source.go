@@ -0,0 +1,76 @@
+package datasource_regions
+
+import (
+	"context"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+func RegionsDataSourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"codes": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "The codes of the matching regions, sorted",
+				MarkdownDescription: "The codes of the matching regions, sorted",
+			},
+			"continent": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return the regions of this group, as accepted in the regions of a monitor: all, africa, apac, asia, europe, north-america, oceania or south-america",
+				MarkdownDescription: "Only return the regions of this group, as accepted in the `regions` of a monitor: `all`, `africa`, `apac`, `asia`, `europe`, `north-america`, `oceania` or `south-america`",
+				Validators: []validator.String{stringvalidator.OneOf(
+					resource_monitor.RegionGroupNames()...,
+				)},
+			},
+			"regions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"city": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The city of the region",
+							MarkdownDescription: "The city of the region",
+						},
+						"code": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The code of the region, as used in the regions of a monitor",
+							MarkdownDescription: "The code of the region, as used in the `regions` of a monitor",
+						},
+						"continent": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The continent of the region",
+							MarkdownDescription: "The continent of the region",
+						},
+						"country": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The country of the region",
+							MarkdownDescription: "The country of the region",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The matching regions, sorted by code",
+				MarkdownDescription: "The matching regions, sorted by code",
+			},
+		},
+	}
+}
+
+type RegionsModel struct {
+	Codes     types.List     `tfsdk:"codes"`
+	Continent types.String   `tfsdk:"continent"`
+	Regions   []RegionsValue `tfsdk:"regions"`
+}
+
+type RegionsValue struct {
+	City      types.String `tfsdk:"city"`
+	Code      types.String `tfsdk:"code"`
+	Continent types.String `tfsdk:"continent"`
+	Country   types.String `tfsdk:"country"`
+}
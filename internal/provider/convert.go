@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func int64sFromList(ctx context.Context, list types.List) ([]int64, diag.Diagnostics) {
+	values := []int64{}
+	if list.IsNull() || list.IsUnknown() {
+		return values, nil
+	}
+
+	var numbers []types.Number
+	diags := list.ElementsAs(ctx, &numbers, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+	for _, number := range numbers {
+		i, _ := number.ValueBigFloat().Int64()
+		values = append(values, i)
+	}
+	return values, diags
+}
+
+func int64sToList(ctx context.Context, values []int64) (types.List, diag.Diagnostics) {
+	numbers := make([]types.Number, 0, len(values))
+	for _, value := range values {
+		numbers = append(numbers, types.NumberValue(big.NewFloat(float64(value))))
+	}
+	return types.ListValueFrom(ctx, types.NumberType, numbers)
+}
+
+// int64Value returns number as an int64, or 0 when it is null or unknown.
+func int64Value(number types.Number) int64 {
+	if number.IsNull() || number.IsUnknown() {
+		return 0
+	}
+	i, _ := number.ValueBigFloat().Int64()
+	return i
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+// apiErrorDetail builds the detail of a diagnostic from an error of the
+// client, spelling out what the API answered and how to fix it when known.
+func apiErrorDetail(summary string, err error) string {
+	var apiErr *client.APIError
+	if !errors.As(err, &apiErr) {
+		return summary + ": " + err.Error()
+	}
+
+	detail := fmt.Sprintf("%s: %s\n\nThe OpenStatus API returned %d", summary, apiErr.Message, apiErr.StatusCode)
+	if apiErr.Code != "" {
+		detail += " (" + apiErr.Code + ")"
+	}
+	detail += "."
+
+	var (
+		unauthorized *client.ErrUnauthorized
+		forbidden    *client.ErrForbidden
+		notFound     *client.ErrNotFound
+	)
+	switch {
+	case errors.As(err, &unauthorized):
+		detail += " Check that openstatus_api_token is set to a valid API key."
+	case errors.As(err, &forbidden):
+		detail += " The API key is not allowed to perform this action, check the plan of the workspace."
+	case errors.As(err, &notFound):
+		detail += " The object does not exist or belongs to another workspace."
+	}
+
+	if apiErr.Docs != "" {
+		detail += "\nSee " + apiErr.Docs
+	}
+	return detail
+}
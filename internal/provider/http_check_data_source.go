@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/datasource_http_check"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*httpCheckDataSource)(nil)
+
+func NewHttpCheckDataSource() datasource.DataSource {
+	return &httpCheckDataSource{}
+}
+
+// httpCheckDataSource runs an ad-hoc check every time it is read, so an
+// endpoint can be validated from every region during plan.
+type httpCheckDataSource struct {
+	client *client.Client
+}
+
+func (d *httpCheckDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	d.client = config.client
+}
+
+func (d *httpCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_http_check"
+}
+
+func (d *httpCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_http_check.HttpCheckDataSourceSchema(ctx)
+}
+
+func (d *httpCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_http_check.HttpCheckModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := client.CheckHttpRequest{
+		Url:        data.Url.ValueString(),
+		Method:     data.Method.ValueString(),
+		Body:       data.Body.ValueString(),
+		RunCount:   int(data.RunCount.ValueInt64()),
+		Aggregated: data.Aggregated.ValueBool(),
+	}
+
+	if !data.Regions.IsNull() {
+		resp.Diagnostics.Append(data.Regions.ElementsAs(ctx, &request.Regions, false)...)
+	}
+	var headers []datasource_http_check.HeadersModel
+	if !data.Headers.IsNull() {
+		resp.Diagnostics.Append(data.Headers.ElementsAs(ctx, &headers, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, header := range headers {
+		request.Headers = append(request.Headers, client.Header{
+			Key:   header.Key.ValueString(),
+			Value: header.Value.ValueString(),
+		})
+	}
+
+	check, err := d.client.CheckHttp(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Error running http check", apiErrorDetail("Could not run the http check", err))
+		return
+	}
+
+	data.Id = types.NumberValue(big.NewFloat(float64(check.Id)))
+
+	data.Timings = make([]datasource_http_check.TimingModel, 0, len(check.Raw))
+	for _, timing := range check.Raw {
+		data.Timings = append(data.Timings, timingModel(timing))
+	}
+
+	data.Responses = make([]datasource_http_check.ResponsesModel, 0, len(check.Response))
+	for _, response := range check.Response {
+		headers, diags := types.MapValueFrom(ctx, types.StringType, response.Headers)
+		resp.Diagnostics.Append(diags...)
+		data.Responses = append(data.Responses, datasource_http_check.ResponsesModel{
+			Body:      types.StringValue(response.Body),
+			Headers:   headers,
+			Latency:   types.Float64Value(response.Latency),
+			Region:    types.StringValue(response.Region),
+			Status:    types.Int64Value(int64(response.Status)),
+			Timestamp: types.Float64Value(response.Timestamp),
+			Timing:    timingModel(response.Timing),
+		})
+	}
+
+	data.AggregatedTiming = nil
+	if check.Aggregated != nil {
+		data.AggregatedTiming = &datasource_http_check.AggregatedModel{
+			Connect:   percentilesModel(check.Aggregated.Connect),
+			Dns:       percentilesModel(check.Aggregated.Dns),
+			FirstByte: percentilesModel(check.Aggregated.FirstByte),
+			Latency:   percentilesModel(check.Aggregated.Latency),
+			Tls:       percentilesModel(check.Aggregated.Tls),
+			Transfer:  percentilesModel(check.Aggregated.Transfer),
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// timingModel turns the timestamps of a check into the duration of each
+// phase.
+func timingModel(timing client.CheckTiming) datasource_http_check.TimingModel {
+	return datasource_http_check.TimingModel{
+		Connect:   types.Float64Value(timing.ConnectDone - timing.ConnectStart),
+		Dns:       types.Float64Value(timing.DnsDone - timing.DnsStart),
+		FirstByte: types.Float64Value(timing.FirstByteDone - timing.FirstByteStart),
+		Tls:       types.Float64Value(timing.TlsHandshakeDone - timing.TlsHandshakeStart),
+		Transfer:  types.Float64Value(timing.TransferDone - timing.TransferStart),
+	}
+}
+
+func percentilesModel(percentiles client.CheckPercentiles) datasource_http_check.PercentilesModel {
+	return datasource_http_check.PercentilesModel{
+		Max: types.Float64Value(percentiles.Max),
+		Min: types.Float64Value(percentiles.Min),
+		P50: types.Float64Value(percentiles.P50),
+		P75: types.Float64Value(percentiles.P75),
+		P95: types.Float64Value(percentiles.P95),
+		P99: types.Float64Value(percentiles.P99),
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccHttpCheckDataSource(t *testing.T) {
+	server := testAccServer(t)
+	var sent []client.CheckHttpRequest
+	server.Check = func(request client.CheckHttpRequest, region string) client.CheckResponse {
+		sent = append(sent, request)
+		status := 200
+		if region == "syd" {
+			status = 503
+		}
+		return client.CheckResponse{
+			Region:  region,
+			Status:  status,
+			Latency: 120,
+			Body:    `{"region":"` + region + `"}`,
+			Headers: map[string]string{"Content-Type": "application/json"},
+			Timing:  client.CheckTiming{DnsStart: 0, DnsDone: 10, ConnectStart: 10, ConnectDone: 30, TransferStart: 100, TransferDone: 120},
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "openstatus_http_check" "test" {
+  url        = "https://example.com/health"
+  method     = "POST"
+  body       = "ping"
+  regions    = ["ams", "syd"]
+  run_count  = 2
+  aggregated = true
+  headers = [{
+    key   = "Accept"
+    value = "application/json"
+  }]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "id", "1"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.#", "2"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.0.region", "ams"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.0.status", "200"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.0.body", `{"region":"ams"}`),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.0.headers.Content-Type", "application/json"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.0.latency", "120"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.0.timing.dns", "10"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.0.timing.connect", "20"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.1.region", "syd"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "responses.1.status", "503"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "timings.#", "4"),
+					resource.TestCheckResourceAttr("data.openstatus_http_check.test", "aggregated_timing.transfer.max", "20"),
+					func(*terraform.State) error {
+						// Once per region and run, for each read of the data source.
+						if len(sent) == 0 || len(sent)%4 != 0 {
+							return fmt.Errorf("unexpected checks %+v", sent)
+						}
+						request := sent[0]
+						if request.Method != "POST" || request.Body != "ping" || len(request.Headers) != 1 || request.Headers[0].Key != "Accept" {
+							return fmt.Errorf("unexpected check request %+v", request)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccConfig(server, `
+data "openstatus_http_check" "test" {
+  url     = "https://example.com/health"
+  regions = ["mars"]
+}
+`),
+				ExpectError: regexp.MustCompile(`Invalid region 'mars'`),
+			},
+		},
+	})
+}
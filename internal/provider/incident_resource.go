@@ -0,0 +1,230 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_incident"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                   = (*incidentResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*incidentResource)(nil)
+)
+
+func NewIncidentResource() resource.Resource {
+	return &incidentResource{}
+}
+
+// incidentResource adopts an incident opened by OpenStatus. Incidents cannot
+// be created or deleted through the API, only acknowledged and resolved.
+type incidentResource struct {
+	client *client.Client
+}
+
+func (r *incidentResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	r.client = config.client
+}
+
+func (r *incidentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_incident"
+}
+
+func (r *incidentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_incident.IncidentResourceSchema(ctx)
+}
+
+// ValidateConfig rejects a timestamp set for a flag configured false, so the
+// conflict fails the plan.
+func (r *incidentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data resource_incident.IncidentModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, state := range []struct {
+		name string
+		flag types.Bool
+		at   types.String
+	}{
+		{"acknowledged", data.Acknowledged, data.AcknowledgedAt},
+		{"resolved", data.Resolved, data.ResolvedAt},
+	} {
+		if isKnown(state.flag) && !state.flag.ValueBool() && !state.at.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root(state.name+"_at"), "Conflicting incident configuration",
+				state.name+" is false but "+state.name+"_at is set, remove "+state.name+"_at or set "+state.name+" to true.")
+		}
+	}
+}
+
+func (r *incidentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_incident.IncidentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *incidentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_incident.IncidentModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	incident, err := r.client.GetIncident(ctx, data.Id.String())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading incident", apiErrorDetail("Could not read the incident", err))
+		return
+	}
+
+	bindIncident(&data, incident)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *incidentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_incident.IncidentModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only forgets the incident, it stays in OpenStatus as it is.
+func (r *incidentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// apply moves the incident to the acknowledged and resolved states planned in
+// data, leaving it untouched when it is already there.
+func (r *incidentResource) apply(ctx context.Context, data *resource_incident.IncidentModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	incident, err := r.client.GetIncident(ctx, data.Id.String())
+	if err != nil {
+		diags.AddError("Error reading incident", apiErrorDetail("Could not read the incident", err))
+		return diags
+	}
+
+	acknowledgedAt := incidentTimestamp(data.Acknowledged, data.AcknowledgedAt, incident.AcknowledgedAt)
+	resolvedAt := incidentTimestamp(data.Resolved, data.ResolvedAt, incident.ResolvedAt)
+
+	if !equalTimestamps(acknowledgedAt, incident.AcknowledgedAt) || !equalTimestamps(resolvedAt, incident.ResolvedAt) {
+		incident, err = r.client.UpdateIncident(ctx, client.IncidentUpdateRequest{
+			AcknowledgedAt: acknowledgedAt,
+			ResolvedAt:     resolvedAt,
+		}, data.Id.String())
+		if err != nil {
+			diags.AddError("Error updating incident", apiErrorDetail("Could not update the incident", err))
+			return diags
+		}
+	}
+
+	bindIncident(data, incident)
+	return diags
+}
+
+// incidentTimestamp returns the timestamp to send for a flag and its
+// timestamp attribute: the configured timestamp, the current one when the
+// flag is already set, or now. ValidateConfig rejects a timestamp configured
+// with the flag false.
+func incidentTimestamp(flag types.Bool, at types.String, current *string) *string {
+	wanted := false
+	if !flag.IsNull() && !flag.IsUnknown() {
+		if !flag.ValueBool() {
+			return nil
+		}
+		wanted = true
+	}
+
+	switch {
+	case !at.IsNull() && !at.IsUnknown():
+		value := at.ValueString()
+		return &value
+	case current != nil || !wanted:
+		return current
+	}
+	now := time.Now().UTC().Format(time.RFC3339)
+	return &now
+}
+
+func equalTimestamps(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func sameInstant(a, b types.String) bool {
+	if a.IsNull() || a.IsUnknown() || b.IsNull() {
+		return false
+	}
+	ta, err := time.Parse(time.RFC3339, a.ValueString())
+	if err != nil {
+		return false
+	}
+	tb, err := time.Parse(time.RFC3339, b.ValueString())
+	if err != nil {
+		return false
+	}
+	return ta.Equal(tb)
+}
+
+// bindIncident copies the incident returned by the API into data. The API may
+// format the timestamps differently than configured, such as Z for +00:00,
+// so the timestamps of data are kept when they are the same instants.
+func bindIncident(data *resource_incident.IncidentModel, incident *client.IncidentRequest) {
+	data.Id = types.NumberValue(big.NewFloat(float64(incident.Id)))
+	data.StartedAt = types.StringPointerValue(incident.StartedAt)
+	data.MonitorId = numberPointerValue(incident.MonitorId)
+	data.Acknowledged = types.BoolValue(incident.AcknowledgedAt != nil)
+	data.AcknowledgedAt = keepInstant(data.AcknowledgedAt, incident.AcknowledgedAt)
+	data.AcknowledgedBy = numberPointerValue(incident.AcknowledgedBy)
+	data.Resolved = types.BoolValue(incident.ResolvedAt != nil)
+	data.ResolvedAt = keepInstant(data.ResolvedAt, incident.ResolvedAt)
+	data.ResolvedBy = numberPointerValue(incident.ResolvedBy)
+}
+
+// keepInstant returns the timestamp returned by the API, or current when it
+// is the same instant.
+func keepInstant(current types.String, returned *string) types.String {
+	value := types.StringPointerValue(returned)
+	if sameInstant(current, value) {
+		return current
+	}
+	return value
+}
+
+func numberPointerValue(value *int64) types.Number {
+	if value == nil {
+		return types.NumberNull()
+	}
+	return types.NumberValue(big.NewFloat(float64(*value)))
+}
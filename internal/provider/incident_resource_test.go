@@ -0,0 +1,114 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIncidentResource(t *testing.T) {
+	server := testAccServer(t)
+	server.Monitors.Put(1, client.MonitorRequest{Id: 1, Name: "test-monitor-terraform-api", Url: "https://example.com", Periodicity: "1m"})
+	incident := server.AddIncident(1)
+
+	config := func(resolved bool) string {
+		return testAccConfig(server, fmt.Sprintf(`
+resource "openstatus_incident" "test" {
+  id           = %d
+  acknowledged = true
+  resolved     = %t
+}
+`, incident.Id, resolved))
+	}
+
+	acknowledgedAt := testAccConfig(server, fmt.Sprintf(`
+resource "openstatus_incident" "test" {
+  id              = %d
+  acknowledged_at = "2024-01-01T00:00:00Z"
+  resolved        = true
+}
+`, incident.Id))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// Incidents cannot be deleted, destroying only forgets them.
+			if _, ok := server.Incidents.Get(incident.Id); !ok {
+				return fmt.Errorf("incident %d was deleted", incident.Id)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, fmt.Sprintf(`
+resource "openstatus_incident" "test" {
+  id          = %d
+  resolved    = false
+  resolved_at = "2024-01-01T00:00:00Z"
+}
+`, incident.Id)),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`resolved is false but resolved_at is set`),
+			},
+			{
+				Config: config(false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_incident.test", "monitor_id", "1"),
+					resource.TestCheckResourceAttr("openstatus_incident.test", "acknowledged", "true"),
+					resource.TestCheckResourceAttrSet("openstatus_incident.test", "acknowledged_at"),
+					resource.TestCheckNoResourceAttr("openstatus_incident.test", "resolved_at"),
+				),
+			},
+			{
+				Config: config(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_incident.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					if i, _ := server.Incidents.Get(incident.Id); i.ResolvedAt == nil {
+						return fmt.Errorf("update not sent to the API: %+v", i)
+					}
+					return nil
+				},
+			},
+			{
+				// Reopened outside of Terraform: the plan resolves it again.
+				PreConfig: func() {
+					i, _ := server.Incidents.Get(incident.Id)
+					i.ResolvedAt, i.ResolvedBy = nil, nil
+					server.Incidents.Put(i.Id, i)
+				},
+				Config: config(true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_incident.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttrSet("openstatus_incident.test", "resolved_at"),
+			},
+			{
+				Config: acknowledgedAt,
+				Check:  resource.TestCheckResourceAttr("openstatus_incident.test", "acknowledged_at", "2024-01-01T00:00:00Z"),
+			},
+			{
+				// The API returns the same instant in another format: no drift.
+				PreConfig: func() {
+					i, _ := server.Incidents.Get(incident.Id)
+					at := "2024-01-01T02:00:00.000+02:00"
+					i.AcknowledgedAt = &at
+					server.Incidents.Put(i.Id, i)
+				},
+				Config:   acknowledgedAt,
+				PlanOnly: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/client/assertions"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// assertionsRequest gathers the assertions of every type configured on the
+// monitor, status assertions first.
+func assertionsRequest(ctx context.Context, data resource_monitor.MonitorModel) ([]client.Assertion, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var status []resource_monitor.StatusAssertionModel
+	var header []resource_monitor.HeaderAssertionModel
+	var textBody []resource_monitor.TextBodyAssertionModel
+	var jsonBody []resource_monitor.JsonBodyAssertionModel
+	diags.Append(elementsAs(ctx, data.StatusAssertions, &status)...)
+	diags.Append(elementsAs(ctx, data.HeaderAssertions, &header)...)
+	diags.Append(elementsAs(ctx, data.TextBodyAssertions, &textBody)...)
+	diags.Append(elementsAs(ctx, data.JsonBodyAssertions, &jsonBody)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	assertions := []client.Assertion{}
+	for _, a := range status {
+		assertions = append(assertions, client.Assertion{Status: &client.StatusAssertion{
+			Compare: a.Compare.ValueString(),
+			Target:  a.Target.ValueInt64(),
+		}})
+	}
+	for _, a := range header {
+		assertions = append(assertions, client.Assertion{Header: &client.HeaderAssertion{
+			Key:     a.Key.ValueString(),
+			Compare: a.Compare.ValueString(),
+			Target:  a.Target.ValueString(),
+		}})
+	}
+	for _, a := range textBody {
+		assertions = append(assertions, client.Assertion{TextBody: &client.TextBodyAssertion{
+			Compare: a.Compare.ValueString(),
+			Target:  a.Target.ValueString(),
+		}})
+	}
+	for _, a := range jsonBody {
+		assertions = append(assertions, client.Assertion{JsonBody: &client.JsonBodyAssertion{
+			Path:    a.Path.ValueString(),
+			Compare: a.Compare.ValueString(),
+			Target:  a.Target.ValueString(),
+		}})
+	}
+	return assertions, diags
+}
+
+// assertionLists holds the assertions of a monitor split by type, as the
+// monitor resource and data sources expose them.
+type assertionLists struct {
+	Status, Header, TextBody, JsonBody types.List
+}
+
+// splitAssertions splits the assertions returned by the API into the list of
+// their type. Assertions of a type the provider does not support are left
+// out and returned apart.
+func splitAssertions(ctx context.Context, assertions []client.Assertion) (assertionLists, []client.Assertion, diag.Diagnostics) {
+	status := []resource_monitor.StatusAssertionModel{}
+	header := []resource_monitor.HeaderAssertionModel{}
+	textBody := []resource_monitor.TextBodyAssertionModel{}
+	jsonBody := []resource_monitor.JsonBodyAssertionModel{}
+	var unsupported []client.Assertion
+	for _, a := range assertions {
+		switch {
+		case a.Status != nil:
+			status = append(status, resource_monitor.StatusAssertionModel{
+				Compare: types.StringValue(a.Status.Compare),
+				Target:  types.Int64Value(a.Status.Target),
+			})
+		case a.Header != nil:
+			header = append(header, resource_monitor.HeaderAssertionModel{
+				Key:     types.StringValue(a.Header.Key),
+				Compare: types.StringValue(a.Header.Compare),
+				Target:  types.StringValue(a.Header.Target),
+			})
+		case a.TextBody != nil:
+			textBody = append(textBody, resource_monitor.TextBodyAssertionModel{
+				Compare: types.StringValue(a.TextBody.Compare),
+				Target:  types.StringValue(a.TextBody.Target),
+			})
+		case a.JsonBody != nil:
+			jsonBody = append(jsonBody, resource_monitor.JsonBodyAssertionModel{
+				Path:    types.StringValue(a.JsonBody.Path),
+				Compare: types.StringValue(a.JsonBody.Compare),
+				Target:  types.StringValue(a.JsonBody.Target),
+			})
+		case a.Other != nil:
+			unsupported = append(unsupported, a)
+		}
+	}
+
+	var lists assertionLists
+	var diags, d diag.Diagnostics
+	lists.Status, d = types.ListValueFrom(ctx, resource_monitor.StatusAssertionType, status)
+	diags.Append(d...)
+	lists.Header, d = types.ListValueFrom(ctx, resource_monitor.HeaderAssertionType, header)
+	diags.Append(d...)
+	lists.TextBody, d = types.ListValueFrom(ctx, resource_monitor.TextBodyAssertionType, textBody)
+	diags.Append(d...)
+	lists.JsonBody, d = types.ListValueFrom(ctx, resource_monitor.JsonBodyAssertionType, jsonBody)
+	diags.Append(d...)
+	return lists, unsupported, diags
+}
+
+// unsupportedAssertion describes an assertion left out by splitAssertions.
+func unsupportedAssertion(monitor string, a client.Assertion) string {
+	return fmt.Sprintf("Monitor %s has an assertion of type %q this provider does not support, it is left out: %s", monitor, a.Type(), a.Other)
+}
+
+// bindAssertions sets the assertion lists of the monitor resource. Updating
+// the monitor removes the assertions the provider does not support.
+func bindAssertions(ctx context.Context, data *resource_monitor.MonitorModel, assertions []client.Assertion) diag.Diagnostics {
+	lists, unsupported, diags := splitAssertions(ctx, assertions)
+	for _, a := range unsupported {
+		diags.AddWarning("Unsupported assertion",
+			unsupportedAssertion(data.Name.ValueString(), a)+"\nThe next update of the monitor removes it.")
+	}
+	data.StatusAssertions, data.HeaderAssertions = lists.Status, lists.Header
+	data.TextBodyAssertions, data.JsonBodyAssertions = lists.TextBody, lists.JsonBody
+	return diags
+}
+
+// elementsAs decodes list into target, leaving it empty when the list is
+// null or unknown.
+func elementsAs(ctx context.Context, list types.List, target interface{}) diag.Diagnostics {
+	if list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	return list.ElementsAs(ctx, target, false)
+}
+
+// checkMonitorAssertions runs an ad-hoc check of the planned http monitor and
+// warns about each assertion the responses fail. It is skipped when nothing
+// the check depends on changed since state, or is not known yet.
+func checkMonitorAssertions(ctx context.Context, c *client.Client, config, plan resource_monitor.MonitorModel, state *resource_monitor.MonitorModel) diag.Diagnostics {
+	// Left unset, type and headers are only known after apply.
+	if plan.Type.IsUnknown() && config.Type.IsNull() {
+		plan.Type = types.StringValue("http")
+	}
+	if plan.Headers.IsUnknown() && config.Headers.IsNull() {
+		plan.Headers = types.ListNull(plan.Headers.ElementType(ctx))
+	}
+
+	request, list, known, diags := checkRequest(ctx, plan)
+	if !known || diags.HasError() || len(list) == 0 {
+		return diags
+	}
+	if state != nil {
+		previous, previousList, _, d := checkRequest(ctx, *state)
+		diags.Append(d...)
+		// The regions are computed when not configured, they do not count.
+		previous.Regions = request.Regions
+		if reflect.DeepEqual(request, previous) && reflect.DeepEqual(list, previousList) {
+			return diags
+		}
+	}
+
+	check, err := c.CheckHttp(ctx, request)
+	if err != nil {
+		diags.AddWarning("Could not check the monitor assertions",
+			apiErrorDetail("The ad-hoc check of "+request.Url+" failed, the assertions were not evaluated", err))
+		return diags
+	}
+
+	// The failures of each assertion, one line per region.
+	failed := make([][]string, len(list))
+	for _, response := range check.Response {
+		for _, failure := range assertions.Evaluate(list, response) {
+			failed[failure.Index] = append(failed[failure.Index], response.Region+": "+failure.Error())
+		}
+	}
+	paths := assertionPaths(list)
+	for i, lines := range failed {
+		if len(lines) == 0 {
+			continue
+		}
+		diags.AddAttributeWarning(paths[i], "Assertion fails",
+			"An ad-hoc check of "+request.Url+" fails this assertion, the monitor will alert as soon as it runs:\n"+
+				strings.Join(lines, "\n"))
+	}
+	return diags
+}
+
+// checkRequest builds the ad-hoc check of an http monitor, and reports
+// whether everything it depends on is known.
+func checkRequest(ctx context.Context, data resource_monitor.MonitorModel) (client.CheckHttpRequest, []client.Assertion, bool, diag.Diagnostics) {
+	if data.Type.IsUnknown() || data.Type.ValueString() != "http" || data.Url.IsNull() {
+		return client.CheckHttpRequest{}, nil, false, nil
+	}
+	for _, value := range []attr.Value{data.Url, data.Method, data.Body, data.Headers,
+		data.StatusAssertions, data.HeaderAssertions, data.TextBodyAssertions, data.JsonBodyAssertions} {
+		if value.IsUnknown() {
+			return client.CheckHttpRequest{}, nil, false, nil
+		}
+	}
+
+	list, diags := assertionsRequest(ctx, data)
+	request := client.CheckHttpRequest{
+		Url:    data.Url.ValueString(),
+		Method: data.Method.ValueString(),
+		Body:   data.Body.ValueString(),
+	}
+	regions, d := regionsRequest(ctx, data)
+	diags.Append(d...)
+	request.Regions = regions
+	var headers []resource_monitor.HeadersValue
+	if !data.Headers.IsNull() {
+		diags.Append(data.Headers.ElementsAs(ctx, &headers, true)...)
+	}
+	for _, header := range headers {
+		request.Headers = append(request.Headers, client.Header{
+			Key:   header.Key.ValueString(),
+			Value: header.Value.ValueString(),
+		})
+	}
+	return request, list, true, diags
+}
+
+// assertionAttributes names the list holding each type of assertion.
+var assertionAttributes = map[string]string{
+	client.AssertionStatus:   "status_assertions",
+	client.AssertionHeader:   "header_assertions",
+	client.AssertionTextBody: "text_body_assertions",
+	client.AssertionJsonBody: "json_body_assertions",
+}
+
+// assertionPaths returns the attribute path of each assertion built by
+// assertionsRequest.
+func assertionPaths(list []client.Assertion) []path.Path {
+	counts := map[string]int{}
+	paths := make([]path.Path, 0, len(list))
+	for _, a := range list {
+		name := assertionAttributes[a.Type()]
+		paths = append(paths, path.Root(name).AtListIndex(counts[name]))
+		counts[name]++
+	}
+	return paths
+}
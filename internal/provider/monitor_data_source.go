@@ -0,0 +1,150 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/datasource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ datasource.DataSource                     = (*monitorDataSource)(nil)
+	_ datasource.DataSourceWithConfigValidators = (*monitorDataSource)(nil)
+)
+
+func NewMonitorDataSource() datasource.DataSource {
+	return &monitorDataSource{}
+}
+
+type monitorDataSource struct {
+	client *client.Client
+}
+
+func (d *monitorDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	d.client = config.client
+}
+
+func (d *monitorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor"
+}
+
+func (d *monitorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_monitor.MonitorDataSourceSchema(ctx)
+}
+
+func (d *monitorDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+			path.MatchRoot("url"),
+		),
+	}
+}
+
+func (d *monitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_monitor.MonitorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var monitor *client.MonitorRequest
+	if !data.Id.IsNull() {
+		var err error
+		monitor, err = d.client.GetMonitor(ctx, data.Id.String())
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading monitor", apiErrorDetail("Could not read the monitor", err))
+			return
+		}
+	} else {
+		monitors, err := d.client.ListMonitors(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing monitors", apiErrorDetail("Could not list the monitors", err))
+			return
+		}
+
+		attribute, value := path.Root("name"), data.Name.ValueString()
+		if data.Name.IsNull() {
+			attribute, value = path.Root("url"), data.Url.ValueString()
+		}
+		for i := range monitors {
+			if (data.Name.IsNull() && monitors[i].Url != value) || (!data.Name.IsNull() && monitors[i].Name != value) {
+				continue
+			}
+			if monitor != nil {
+				resp.Diagnostics.AddAttributeError(attribute, "Multiple monitors found",
+					"More than one monitor has the "+attribute.String()+" "+value+", look it up by id instead.")
+				return
+			}
+			monitor = &monitors[i]
+		}
+		if monitor == nil {
+			resp.Diagnostics.AddAttributeError(attribute, "Monitor not found",
+				"No monitor has the "+attribute.String()+" "+value+".")
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(bindMonitorData(ctx, &data, monitor)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// bindMonitorData copies the monitor returned by the API into the model of
+// the monitor data sources.
+func bindMonitorData(ctx context.Context, data *datasource_monitor.MonitorModel, monitor *client.MonitorRequest) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.NumberValue(big.NewFloat(float64(monitor.Id)))
+	data.Active = types.BoolValue(monitor.Active)
+	data.Body = types.StringValue(monitor.Body)
+	data.Description = types.StringValue(monitor.Description)
+	data.Url = types.StringValue(monitor.Url)
+	data.Name = types.StringValue(monitor.Name)
+	data.Periodicity = types.StringValue(monitor.Periodicity)
+	data.Method = types.StringValue(monitor.Method)
+	data.Public = types.BoolValue(monitor.Public)
+	data.Timeout = types.NumberValue(big.NewFloat(float64(monitor.Timeout)))
+	data.DegradedAfter = types.NumberValue(big.NewFloat(float64(monitor.DegradedAfter)))
+	data.Type = types.StringValue(monitor.Type)
+
+	regions := monitor.Regions
+	if regions == nil {
+		regions = []string{}
+	}
+	data.Regions, d = types.ListValueFrom(ctx, types.StringType, regions)
+	diags.Append(d...)
+
+	headers := make([]datasource_monitor.HeaderModel, 0, len(monitor.Headers))
+	for _, header := range monitor.Headers {
+		headers = append(headers, datasource_monitor.HeaderModel{
+			Key:   types.StringValue(header.Key),
+			Value: types.StringValue(header.Value),
+		})
+	}
+	data.Headers, d = types.ListValueFrom(ctx, datasource_monitor.HeaderType, headers)
+	diags.Append(d...)
+
+	lists, unsupported, d := splitAssertions(ctx, monitor.Assertions)
+	diags.Append(d...)
+	for _, a := range unsupported {
+		diags.AddWarning("Unsupported assertion", unsupportedAssertion(monitor.Name, a))
+	}
+	data.StatusAssertions, data.HeaderAssertions = lists.Status, lists.Header
+	data.TextBodyAssertions, data.JsonBodyAssertions = lists.TextBody, lists.JsonBody
+	return diags
+}
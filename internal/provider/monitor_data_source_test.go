@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMonitorDataSource(t *testing.T) {
+	server := testAccServer(t)
+	server.Monitors.Put(1, client.MonitorRequest{
+		Id: 1, Name: "test-monitor-terraform-api", Url: "https://example.com/health", Periodicity: "5m", Method: "GET", Type: "http",
+		Active: true, Regions: []string{"ams", "iad"}, Timeout: 30000, DegradedAfter: 10000,
+		Headers: []client.Header{{Key: "Accept", Value: "application/json"}},
+		Assertions: []client.Assertion{
+			{Status: &client.StatusAssertion{Compare: "eq", Target: 200}},
+			{JsonBody: &client.JsonBodyAssertion{Path: "$.status", Compare: "eq", Target: "ok"}},
+		},
+	})
+	server.Monitors.Put(2, client.MonitorRequest{
+		Id: 2, Name: "test-monitor-terraform-db", Url: "db.example.com:5432", Periodicity: "1m", Type: "tcp", Regions: []string{"fra"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "openstatus_monitor" "by_id" {
+  id = 1
+}
+
+data "openstatus_monitor" "by_name" {
+  name = "test-monitor-terraform-db"
+}
+
+data "openstatus_monitor" "by_url" {
+  url = "https://example.com/health"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "name", "test-monitor-terraform-api"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "type", "http"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "timeout", "30000"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "regions.#", "2"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "regions.1", "iad"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "headers.0.key", "Accept"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "headers.0.value", "application/json"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "status_assertions.0.target", "200"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "header_assertions.#", "0"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_id", "json_body_assertions.0.path", "$.status"),
+					resource.TestCheckNoResourceAttr("data.openstatus_monitor.by_id", "exclude_regions"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_name", "id", "2"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_name", "url", "db.example.com:5432"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_name", "headers.#", "0"),
+					resource.TestCheckResourceAttr("data.openstatus_monitor.by_url", "id", "1"),
+				),
+			},
+			{
+				Config: testAccConfig(server, `
+data "openstatus_monitor" "missing" {
+  name = "test-monitor-terraform-missing"
+}
+`),
+				ExpectError: regexp.MustCompile(`No monitor has the name test-monitor-terraform-missing`),
+			},
+			{
+				Config: testAccConfig(server, `
+data "openstatus_monitor" "missing" {
+  id = 3
+}
+`),
+				ExpectError: regexp.MustCompile(`Error reading monitor`),
+			},
+		},
+	})
+}
@@ -2,27 +2,38 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
+	"fmt"
 	"math/big"
+	"net"
+	"net/url"
+	"slices"
 	"strconv"
+	"strings"
 
-	"terraform-provider-openstatus/client"
-	"terraform-provider-openstatus/internal/resource_monitor"
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_monitor"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	hreq "github.com/imroc/req/v3"
 )
 
-var _ resource.Resource = (*monitorResource)(nil)
+var (
+	_ resource.Resource                   = (*monitorResource)(nil)
+	_ resource.ResourceWithImportState    = (*monitorResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*monitorResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*monitorResource)(nil)
+)
 
 func NewMonitorResource() resource.Resource {
 	return &monitorResource{}
 }
 
 type monitorResource struct {
-	client *hreq.Client
+	client          *client.Client
+	checkAssertions bool
 }
 
 func (r *monitorResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
@@ -31,6 +42,7 @@ func (r *monitorResource) Configure(_ context.Context, req resource.ConfigureReq
 	}
 	config := req.ProviderData.(ProviderConfig)
 	r.client = config.client
+	r.checkAssertions = config.checkAssertions
 }
 
 func (r *monitorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -41,121 +53,130 @@ func (r *monitorResource) Schema(ctx context.Context, req resource.SchemaRequest
 	resp.Schema = resource_monitor.MonitorResourceSchema(ctx)
 }
 
-func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+// ValidateConfig checks the rules that depend on the type of the monitor, so
+// they fail the plan instead of the API request. Only those rules are skipped
+// while the type is unknown.
+func (r *monitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var data resource_monitor.MonitorModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
-	resp.Diagnostics.Append(bindObject(ctx, &data)...)
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var regions []string
-	regionsTF := make([]types.String, 0, len(data.Regions.Elements()))
-	diags := data.Regions.ElementsAs(ctx, &regions, false)
-	if diags.HasError() {
-		return
-	}
 
-	for _, region := range regionsTF {
-		regions = append(regions, region.ValueString())
+	monitorType := data.Type.ValueString()
+	if data.Type.IsNull() {
+		monitorType = "http"
 	}
+	typeKnown := !data.Type.IsUnknown()
 
-	var headers []struct {
-		Key   string `json:"key"`
-		Value string `json:"value"`
+	if typeKnown && monitorType == "tcp" {
+		httpOnly := []struct {
+			name  string
+			value attr.Value
+		}{
+			{"method", data.Method},
+			{"body", data.Body},
+			{"headers", data.Headers},
+			{"status_assertions", data.StatusAssertions},
+			{"header_assertions", data.HeaderAssertions},
+			{"text_body_assertions", data.TextBodyAssertions},
+			{"json_body_assertions", data.JsonBodyAssertions},
+		}
+		for _, attribute := range httpOnly {
+			if isKnown(attribute.value) {
+				resp.Diagnostics.AddAttributeError(path.Root(attribute.name), "Invalid attribute for a tcp monitor",
+					attribute.name+" only applies to http monitors, remove it or set type to http.")
+			}
+		}
 	}
 
-	var headersTF []resource_monitor.HeadersValue
-	diags = data.Headers.ElementsAs(ctx, &headersTF, true)
-	if diags.HasError() {
-		return
+	if isKnown(data.Regions) && isKnown(data.ExcludeRegions) {
+		regions, diags := regionsRequest(ctx, data)
+		resp.Diagnostics.Append(diags...)
+		if !diags.HasError() && len(regions) == 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("exclude_regions"), "No region left",
+				"exclude_regions removes every region of regions, keep at least one.")
+		}
 	}
-	for _, header := range headersTF {
-		headers = append(headers, struct {
-			Key   string `json:"key"`
-			Value string `json:"value"`
-		}{
-			Key:   header.Key.ValueString(),
-			Value: header.Value.ValueString(),
-		})
+	if data.Regions.IsNull() && isKnown(data.ExcludeRegions) {
+		resp.Diagnostics.AddAttributeError(path.Root("exclude_regions"), "Missing regions",
+			"exclude_regions only applies to regions, set regions such as [\"all\"] as well.")
 	}
 
-	var assertions []interface{}
-
-	var assertionsTF []resource_monitor.AssertionsValue
-	diags = data.Assertions.ElementsAs(ctx, &assertionsTF, true)
-	if diags.HasError() {
-		return
+	if typeKnown && isKnown(data.Url) {
+		if err := validateMonitorUrl(monitorType, data.Url.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("url"), "Invalid url", err.Error())
+		}
 	}
 
-	for _, assert := range assertionsTF {
-		if assert.AssertionsType.ValueString() == "status" {
-			i, _ := strconv.Atoi(assert.Target.ValueString())
-
-			assertions = append(assertions, struct {
-				Target  int    `json:"target"`
-				Type    string `json:"type"`
-				Compare string `json:"compare"`
-			}{
-				Target:  i,
-				Type:    assert.AssertionsType.ValueString(),
-				Compare: assert.Compare.ValueString(),
-			})
-		} else {
-			assertions = append(assertions, struct {
-				Target  string `json:"target"`
-				Type    string `json:"type"`
-				Compare string `json:"compare"`
-				Key     string `json:"key"`
-			}{
-				Target:  assert.Target.ValueString(),
-				Type:    assert.AssertionsType.ValueString(),
-				Compare: assert.Compare.ValueString(),
-				Key:     assert.Key.ValueString(),
-			})
+	// Left unset, the timeout is the default of the API.
+	if isKnown(data.DegradedAfter) && !data.Timeout.IsUnknown() {
+		timeout, description := data.Timeout.ValueBigFloat(), "the timeout of "+data.Timeout.String()
+		if data.Timeout.IsNull() {
+			timeout = big.NewFloat(client.DefaultMonitorTimeout)
+			description = fmt.Sprintf("the default timeout of %d, set timeout as well", client.DefaultMonitorTimeout)
+		}
+		if data.DegradedAfter.ValueBigFloat().Cmp(timeout) > 0 {
+			resp.Diagnostics.AddAttributeError(path.Root("degraded_after"), "Invalid degraded_after",
+				"degraded_after is "+data.DegradedAfter.String()+", it cannot exceed "+description+".")
 		}
-
 	}
-	timeout, _ := data.Timeout.ValueBigFloat().Int64()
-	degradedAfter, _ := data.DegradedAfter.ValueBigFloat().Int64()
-	b, _ := json.Marshal(assertions)
+}
 
-	t := json.RawMessage(b)
+// ModifyPlan runs an ad-hoc check of a created or changed http monitor when
+// check_assertions is set, and warns about the assertions it would fail.
+//
+// Terraform plans again during apply, in a new provider process and with
+// nothing telling the two plans apart, so an applied change is checked twice
+// and its warnings are shown at plan and at apply.
+func (r *monitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !r.checkAssertions || req.Plan.Raw.IsNull() {
+		return
+	}
+	var config, plan resource_monitor.MonitorModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var state *resource_monitor.MonitorModel
+	if !req.State.Raw.IsNull() {
+		state = &resource_monitor.MonitorModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	resp.Diagnostics.Append(checkMonitorAssertions(ctx, r.client, config, plan, state)...)
+}
 
-	out, err := client.CreateMonitor(ctx, r.client, client.MonitorRequest{
-		Active:      data.Active.ValueBool(),
-		Body:        data.Body.ValueString(),
-		Description: data.Description.ValueString(),
-		Headers:     headers,
+func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_monitor.MonitorModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(bindObject(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	request, diags := monitorRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-		Url:           data.Url.ValueString(),
-		Name:          data.Name.ValueString(),
-		Periodicity:   data.Periodicity.ValueString(),
-		Regions:       regions,
-		Method:        data.Method.ValueString(),
-		Timeout:       int(timeout),
-		DegradedAfter: int(degradedAfter),
-		Assertions:    t,
-		Type:          data.Type.ValueString(),
-	})
+	out, err := r.client.CreateMonitor(ctx, request)
 
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating monitor", "Could not create the monitor:"+err.Error())
+		resp.Diagnostics.AddError("Error creating monitor", apiErrorDetail("Could not create the monitor", err))
 		return
 	}
 
-	data.Id = types.NumberValue(big.NewFloat(float64(out.Id)))
-	data.Active = types.BoolValue(out.Active)
-	data.Body = types.StringValue(out.Body)
-	data.Description = types.StringValue(out.Description)
-	data.Url = types.StringValue(out.Url)
-	data.Name = types.StringValue(out.Name)
-	data.Periodicity = types.StringValue(out.Periodicity)
-	data.Method = types.StringValue(out.Method)
-	data.DegradedAfter = types.NumberValue(big.NewFloat(float64(out.DegradedAfter)))
-	data.Timeout = types.NumberValue(big.NewFloat(float64(out.Timeout)))
+	resp.Diagnostics.Append(bindMonitor(ctx, &data, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -169,20 +190,20 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	if !data.Id.IsNull() {
-		monitor, err := client.GetMonitor(ctx, r.client, data.Id.String())
+		monitor, err := r.client.GetMonitor(ctx, data.Id.String())
+		if client.IsNotFound(err) {
+			// Deleted outside of Terraform, plan a new one.
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		if err != nil {
-			resp.Diagnostics.AddError("Error reading monitor", "Could not read the monitor:"+err.Error())
+			resp.Diagnostics.AddError("Error reading monitor", apiErrorDetail("Could not read the monitor", err))
+			return
+		}
+		resp.Diagnostics.Append(bindMonitor(ctx, &data, monitor)...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		data.Id = types.NumberValue(big.NewFloat(float64(monitor.Id)))
-		data.Active = types.BoolValue(monitor.Active)
-		data.Body = types.StringValue(monitor.Body)
-		data.Description = types.StringValue(monitor.Description)
-		data.Url = types.StringValue(monitor.Url)
-		data.Name = types.StringValue(monitor.Name)
-		data.Periodicity = types.StringValue(monitor.Periodicity)
-		data.Method = types.StringValue(monitor.Method)
-		data.Type = types.StringValue(monitor.Type)
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -193,11 +214,46 @@ func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 }
 
-func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+// ImportState accepts the numeric id of the monitor, or its name prefixed
+// with "name:" such as name:checkout-api.
+func (r *monitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var id int64
+	if name, ok := strings.CutPrefix(req.ID, "name:"); ok {
+		monitors, err := r.client.ListMonitors(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError("Error listing monitors", apiErrorDetail("Could not list the monitors", err))
+			return
+		}
+		matches := 0
+		for _, monitor := range monitors {
+			if monitor.Name == name {
+				id = monitor.Id
+				matches++
+			}
+		}
+		switch {
+		case matches == 0:
+			resp.Diagnostics.AddError("Monitor not found", "No monitor is named "+name+".")
+			return
+		case matches > 1:
+			resp.Diagnostics.AddError("Multiple monitors found",
+				"More than one monitor is named "+name+", import it by id instead.")
+			return
+		}
+	} else {
+		var err error
+		id, err = strconv.ParseInt(req.ID, 10, 64)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import id",
+				"Expected the numeric id of the monitor or name:<monitor name>, got: "+req.ID)
+			return
+		}
+	}
 
-	var before resource_monitor.MonitorModel
-	req.State.Get(ctx, &before)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.NumberValue(big.NewFloat(float64(id))))...)
+}
 
+func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data resource_monitor.MonitorModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -207,80 +263,70 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	var regions []string
-	regionsTF := make([]types.String, 0, len(data.Regions.Elements()))
-	diags := data.Regions.ElementsAs(ctx, &regions, false)
-	if diags.HasError() {
+	request, diags := monitorRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.UpdateMonitor(ctx, request, data.Id.String())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating monitor", apiErrorDetail("Could not update the monitor", err))
+		return
+	}
+
+	resp.Diagnostics.Append(bindMonitor(ctx, &data, out)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	for _, region := range regionsTF {
-		regions = append(regions, region.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_monitor.MonitorModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMonitor(ctx, data.Id.String())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting monitor", apiErrorDetail("Could not delete the monitor", err))
+		return
 	}
+}
 
-	var headers []struct {
-		Key   string `json:"key"`
-		Value string `json:"value"`
+// monitorRequest builds the monitor sent to the API from the planned model.
+func monitorRequest(ctx context.Context, data resource_monitor.MonitorModel) (client.MonitorRequest, diag.Diagnostics) {
+	regions, diags := regionsRequest(ctx, data)
+	if diags.HasError() {
+		return client.MonitorRequest{}, diags
 	}
 
+	var headers []client.Header
+
 	var headersTF []resource_monitor.HeadersValue
 	diags = data.Headers.ElementsAs(ctx, &headersTF, true)
 	if diags.HasError() {
-		return
+		return client.MonitorRequest{}, diags
 	}
 	for _, header := range headersTF {
-		headers = append(headers, struct {
-			Key   string `json:"key"`
-			Value string `json:"value"`
-		}{
+		headers = append(headers, client.Header{
 			Key:   header.Key.ValueString(),
 			Value: header.Value.ValueString(),
 		})
 	}
 
-	var assertions []interface{}
-
-	var assertionsTF []resource_monitor.AssertionsValue
-	diags = data.Assertions.ElementsAs(ctx, &assertionsTF, true)
+	assertions, diags := assertionsRequest(ctx, data)
 	if diags.HasError() {
-		return
-	}
-	for _, assert := range assertionsTF {
-		if assert.AssertionsType.ValueString() == "status" {
-
-			i, _ := strconv.Atoi(assert.Target.ValueString())
-			assertions = append(assertions, struct {
-				Target  int    `json:"target"`
-				Type    string `json:"type"`
-				Compare string `json:"compare"`
-			}{
-				Target:  i,
-				Type:    assert.AssertionsType.ValueString(),
-				Compare: assert.Compare.ValueString(),
-			})
-		} else {
-			assertions = append(assertions, struct {
-				Target  string `json:"target"`
-				Type    string `json:"type"`
-				Compare string `json:"compare"`
-				Key     string `json:"key"`
-			}{
-				Target:  assert.Target.ValueString(),
-				Type:    assert.AssertionsType.ValueString(),
-				Compare: assert.Compare.ValueString(),
-				Key:     assert.Key.ValueString(),
-			})
-		}
+		return client.MonitorRequest{}, diags
 	}
 
-	timeout, _ := data.Timeout.ValueBigFloat().Int64()
-	degradedAfter, _ := data.DegradedAfter.ValueBigFloat().Int64()
-
-	b, _ := json.Marshal(assertions)
-
-	t := json.RawMessage(b)
-	json.RawMessage.MarshalJSON(b)
-	out, err := client.UpdateMonitor(ctx, r.client, client.MonitorRequest{
+	timeout := int64Value(data.Timeout)
+	degradedAfter := int64Value(data.DegradedAfter)
+	return client.MonitorRequest{
 		Active:      data.Active.ValueBool(),
 		Body:        data.Body.ValueString(),
 		Description: data.Description.ValueString(),
@@ -291,43 +337,54 @@ func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		Periodicity:   data.Periodicity.ValueString(),
 		Regions:       regions,
 		Method:        data.Method.ValueString(),
+		Public:        data.Public.ValueBool(),
 		Timeout:       int(timeout),
 		DegradedAfter: int(degradedAfter),
-		Assertions:    t,
-	}, data.Id.String())
+		Assertions:    assertions,
+		Type:          data.Type.ValueString(),
+	}, nil
+}
 
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating monitor", "Could not create the monitor:")
-		return
+// validateMonitorUrl checks url is an http(s) URL for an http monitor, and
+// host:port for a tcp monitor.
+func validateMonitorUrl(monitorType, rawUrl string) error {
+	switch monitorType {
+	case "http":
+		u, err := url.Parse(rawUrl)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("expected an http or https URL such as https://example.com/health for an http monitor, got: %s", rawUrl)
+		}
+	case "tcp":
+		host, port, err := net.SplitHostPort(rawUrl)
+		if n, portErr := strconv.Atoi(port); err != nil || host == "" || portErr != nil || n < 1 || n > 65535 {
+			return fmt.Errorf("expected host:port such as example.com:443 for a tcp monitor, got: %s", rawUrl)
+		}
 	}
-
-	data.Id = types.NumberValue(big.NewFloat(float64(out.Id)))
-	data.Active = types.BoolValue(out.Active)
-	data.Body = types.StringValue(out.Body)
-	data.Description = types.StringValue(out.Description)
-	data.Url = types.StringValue(out.Url)
-	data.Name = types.StringValue(out.Name)
-	data.Periodicity = types.StringValue(out.Periodicity)
-	data.Method = types.StringValue(out.Method)
-	data.DegradedAfter = types.NumberValue(big.NewFloat(float64(out.DegradedAfter)))
-	data.Timeout = types.NumberValue(big.NewFloat(float64(out.Timeout)))
-	data.Type = types.StringValue(out.Type)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-
+	return nil
 }
 
-func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data resource_monitor.MonitorModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+// regionsRequest expands the region groups of the model, without the
+// excluded regions.
+func regionsRequest(ctx context.Context, data resource_monitor.MonitorModel) ([]string, diag.Diagnostics) {
+	var regions, exclude []string
+	diags := elementsAs(ctx, data.Regions, &regions)
+	diags.Append(elementsAs(ctx, data.ExcludeRegions, &exclude)...)
+	if diags.HasError() || data.Regions.IsNull() {
+		return nil, diags
 	}
+	return resource_monitor.ExpandRegions(regions, exclude), diags
+}
 
-	err := client.DeleteMonitor(ctx, r.client, data.Id.String())
-	if err != nil {
-		resp.Diagnostics.AddError("Error creating monitor", "Could not create the monitor:")
-		return
-	}
+// sameRegions reports whether a and b hold the same regions, in any order.
+func sameRegions(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
+
+func isKnown(value attr.Value) bool {
+	return !value.IsNull() && !value.IsUnknown()
 }
 
 func bindObject(ctx context.Context, monitor *resource_monitor.MonitorModel) diag.Diagnostics {
@@ -358,7 +415,7 @@ func bindObject(ctx context.Context, monitor *resource_monitor.MonitorModel) dia
 		monitor.DegradedAfter = types.NumberNull()
 	}
 
-	if monitor.Regions.IsNull() {
+	if monitor.Regions.IsNull() || monitor.Regions.IsUnknown() {
 		monitor.Regions = types.ListNull(types.StringNull().Type(ctx))
 	} else if !monitor.Regions.IsNull() {
 		var regions []string
@@ -400,26 +457,62 @@ func bindObject(ctx context.Context, monitor *resource_monitor.MonitorModel) dia
 		}
 	}
 
-	if monitor.Assertions.IsNull() || monitor.Assertions.IsUnknown() {
-		monitor.Assertions = types.ListNull(resource_monitor.AssertionsValue{}.Type(ctx))
-	} else {
-		var assertions []resource_monitor.AssertionsValue
-		diags := monitor.Assertions.ElementsAs(ctx, &assertions, true)
-		if diags.HasError() {
-			return diags
-		}
+	return nil
+}
 
-		for i := range assertions {
-			if assertions[i].Key.IsUnknown() {
-				assertions[i].Key = types.StringNull()
-			}
+// bindMonitor copies every attribute of the monitor returned by the API into
+// the model, splitting its assertions by type into status_assertions,
+// header_assertions, text_body_assertions and json_body_assertions.
+func bindMonitor(ctx context.Context, data *resource_monitor.MonitorModel, monitor *client.MonitorRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.NumberValue(big.NewFloat(float64(monitor.Id)))
+	data.Active = types.BoolValue(monitor.Active)
+	data.Body = types.StringValue(monitor.Body)
+	data.Description = types.StringValue(monitor.Description)
+	data.Url = types.StringValue(monitor.Url)
+	data.Name = types.StringValue(monitor.Name)
+	data.Periodicity = types.StringValue(monitor.Periodicity)
+	data.Method = types.StringValue(monitor.Method)
+	data.Public = types.BoolValue(monitor.Public)
+	data.Timeout = types.NumberValue(big.NewFloat(float64(monitor.Timeout)))
+	data.DegradedAfter = types.NumberValue(big.NewFloat(float64(monitor.DegradedAfter)))
+	data.Type = types.StringValue(monitor.Type)
+
+	if data.ExcludeRegions.IsNull() {
+		data.ExcludeRegions = types.ListNull(types.StringType)
+	}
+	// Groups such as europe stay in the state as long as they expand to the
+	// regions of the monitor.
+	planned, d := regionsRequest(ctx, *data)
+	if d.HasError() || data.Regions.IsNull() || !sameRegions(planned, monitor.Regions) {
+		regions := monitor.Regions
+		if regions == nil {
+			regions = []string{}
 		}
-
-		monitor.Assertions, diags = types.ListValueFrom(ctx, resource_monitor.AssertionsValue{}.Type(ctx), assertions)
+		data.Regions, diags = types.ListValueFrom(ctx, types.StringType, regions)
 		if diags.HasError() {
 			return diags
 		}
 	}
 
-	return nil
+	headers := make([]resource_monitor.HeadersValue, 0, len(monitor.Headers))
+	for _, header := range monitor.Headers {
+		value, d := resource_monitor.NewHeadersValue(resource_monitor.HeadersValue{}.AttributeTypes(ctx), map[string]attr.Value{
+			"key":   types.StringValue(header.Key),
+			"value": types.StringValue(header.Value),
+		})
+		diags.Append(d...)
+		headers = append(headers, value)
+	}
+	if diags.HasError() {
+		return diags
+	}
+	data.Headers, diags = types.ListValueFrom(ctx, resource_monitor.HeadersValue{}.Type(ctx), headers)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(bindAssertions(ctx, data, monitor.Assertions)...)
+	return diags
 }
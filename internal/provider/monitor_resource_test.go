@@ -0,0 +1,439 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccMonitorResource(t *testing.T) {
+	server := testAccServer(t)
+
+	monitor := func(check func(client.MonitorRequest) error) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			id, err := testAccId(s, "openstatus_monitor.test")
+			if err != nil {
+				return err
+			}
+			monitor, ok := server.Monitors.Get(id)
+			if !ok {
+				return fmt.Errorf("monitor %d not found in the API", id)
+			}
+			return check(monitor)
+		}
+	}
+
+	created := testAccConfig(server, `
+resource "openstatus_monitor" "test" {
+  name        = "test-monitor-terraform-api"
+  url         = "https://example.com/health"
+  periodicity = "5m"
+  regions     = ["ams", "iad"]
+  headers = [{
+    key   = "Accept"
+    value = "application/json"
+  }]
+  status_assertions = [{
+    compare = "eq"
+    target  = 200
+  }]
+  header_assertions = [{
+    key     = "content-type"
+    compare = "contains"
+    target  = "json"
+  }]
+  text_body_assertions = [{
+    compare = "not_empty"
+  }]
+  json_body_assertions = [{
+    path    = "$.status"
+    compare = "eq"
+    target  = "ok"
+  }]
+}
+`)
+	updated := testAccConfig(server, `
+resource "openstatus_monitor" "test" {
+  name        = "test-monitor-terraform-api"
+  description = "Health of the API"
+  url         = "example.com:443"
+  type        = "tcp"
+  periodicity = "1m"
+  regions     = ["ams"]
+  public      = true
+  active      = true
+}
+`)
+
+	// invalid plans a monitor with attributes, expecting message.
+	invalid := func(attributes, message string) resource.TestStep {
+		return resource.TestStep{
+			Config: testAccConfig(server, `
+resource "openstatus_monitor" "test" {
+  name        = "test-monitor-terraform-api"
+  periodicity = "5m"
+`+attributes+`}
+`),
+			PlanOnly:    true,
+			ExpectError: regexp.MustCompile(message),
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if n := server.Monitors.Len(); n != 0 {
+				return fmt.Errorf("%d monitors left in the API", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+resource "openstatus_monitor" "test" {
+  name        = "test-monitor-terraform-api"
+  url         = "https://example.com/health"
+  periodicity = "5m"
+  status_assertions = [{
+    compare = "eq"
+    target  = 20
+  }]
+}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be between 100 and 599`),
+			},
+			invalid(`
+  url  = "example.com:443"
+  type = "tcp"
+  body = "ping"
+  status_assertions = [{
+    compare = "eq"
+    target  = 200
+  }]
+`, `Invalid attribute for a tcp monitor`),
+			invalid(`
+  url = "example.com/health"
+`, `expected an http or https URL`),
+			invalid(`
+  url  = "https://example.com:443"
+  type = "tcp"
+`, `expected host:port`),
+			invalid(`
+  url            = "https://example.com/health"
+  timeout        = 10000
+  degraded_after = 30000
+`, `it cannot exceed the timeout of 10000`),
+			invalid(`
+  url            = "https://example.com/health"
+  degraded_after = 60000
+`, `it cannot exceed the default timeout of 45000`),
+			{
+				// The type is only known after apply, the timeout is still checked.
+				Config: testAccConfig(server, `
+resource "terraform_data" "type" {
+  input = "http"
+}
+
+resource "openstatus_monitor" "test" {
+  name           = "test-monitor-terraform-api"
+  url            = "https://example.com/health"
+  periodicity    = "5m"
+  type           = terraform_data.type.output
+  timeout        = 10000
+  degraded_after = 30000
+}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`it cannot exceed the timeout of 10000`),
+			},
+			invalid(`
+  url     = "https://example.com/health"
+  regions = ["europe", "mars"]
+`, `value must be one of`),
+			invalid(`
+  url             = "https://example.com/health"
+  regions         = ["ams", "fra"]
+  exclude_regions = ["europe"]
+`, `No region left`),
+			{
+				Config: created,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "id", "1"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "method", "GET"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "type", "http"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "regions.#", "2"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "headers.0.key", "Accept"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "status_assertions.0.target", "200"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "header_assertions.0.key", "content-type"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "text_body_assertions.0.target", ""),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "json_body_assertions.0.path", "$.status"),
+					monitor(func(m client.MonitorRequest) error {
+						if m.Name != "test-monitor-terraform-api" || m.Periodicity != "5m" || len(m.Regions) != 2 || len(m.Assertions) != 4 ||
+							m.Assertions[0].Status.Target != 200 || m.Assertions[3].JsonBody.Path != "$.status" {
+							return fmt.Errorf("unexpected monitor %+v", m)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// An assertion type added to the API later does not fail the
+				// refresh, it is left out of the state.
+				PreConfig: func() {
+					m, _ := server.Monitors.Get(1)
+					m.Assertions = append(m.Assertions, client.Assertion{Other: json.RawMessage(`{"type":"latency","compare":"lt","target":500}`)})
+					server.Monitors.Put(1, m)
+				},
+				Config:   created,
+				PlanOnly: true,
+			},
+			{
+				Config: updated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "id", "1"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "public", "true"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "headers.#", "0"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "status_assertions.#", "0"),
+					monitor(func(m client.MonitorRequest) error {
+						if !m.Public || !m.Active || m.Type != "tcp" || m.Description != "Health of the API" ||
+							len(m.Headers) != 0 || len(m.Assertions) != 0 {
+							return fmt.Errorf("update not sent to the API: %+v", m)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      "openstatus_monitor.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				ResourceName:      "openstatus_monitor.test",
+				ImportState:       true,
+				ImportStateId:     "name:test-monitor-terraform-api",
+				ImportStateVerify: true,
+			},
+			{
+				// Changed in the dashboard: the plan puts it back.
+				PreConfig: func() {
+					m, _ := server.Monitors.Get(1)
+					m.Public = false
+					m.Regions = []string{"ams", "syd"}
+					server.Monitors.Put(1, m)
+				},
+				Config: updated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: monitor(func(m client.MonitorRequest) error {
+					if !m.Public || len(m.Regions) != 1 {
+						return fmt.Errorf("drift not corrected: %+v", m)
+					}
+					return nil
+				}),
+			},
+			{
+				// Deleted in the dashboard: the plan creates it again.
+				PreConfig: func() {
+					server.Monitors.Delete(1)
+				},
+				Config: updated,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_monitor.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("openstatus_monitor.test", "id", "2"),
+			},
+		},
+	})
+}
+
+// testAccPlanWarnings serves the provider and collects the warnings of every
+// resource plan, which the acceptance tests cannot see otherwise.
+type testAccPlanWarnings struct {
+	tfprotov6.ProviderServer
+	warnings *[]*tfprotov6.Diagnostic
+}
+
+func (s testAccPlanWarnings) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		for _, d := range resp.Diagnostics {
+			if d.Severity == tfprotov6.DiagnosticSeverityWarning {
+				*s.warnings = append(*s.warnings, d)
+			}
+		}
+	}
+	return resp, err
+}
+
+func TestAccMonitorResourceCheckAssertions(t *testing.T) {
+	server := testAccServer(t)
+	var checked []string
+	server.Check = func(request client.CheckHttpRequest, region string) client.CheckResponse {
+		checked = append(checked, request.Url+" "+region)
+		return client.CheckResponse{Region: region, Status: 503, Body: `{"status":"down"}`}
+	}
+	var warnings []*tfprotov6.Diagnostic
+
+	config := func(url string) string {
+		return fmt.Sprintf(`
+provider "openstatus" {
+  endpoint             = %q
+  openstatus_api_token = %q
+  retry_max_attempts   = 1
+  check_assertions     = true
+}
+
+resource "openstatus_monitor" "test" {
+  name        = "test-monitor-terraform-api"
+  url         = %q
+  periodicity = "5m"
+  regions     = ["ams", "iad"]
+  status_assertions = [{
+    compare = "eq"
+    target  = 200
+  }]
+  json_body_assertions = [{
+    path    = "$.status"
+    compare = "eq"
+    target  = "ok"
+  }]
+}
+`, server.Endpoint(), testAccToken, url)
+	}
+
+	// checks expects the ad-hoc check of url to run twice, at plan and when
+	// apply plans again, each time warning about both assertions.
+	checks := func(url string) resource.TestCheckFunc {
+		return func(*terraform.State) error {
+			want := []string{url + " ams", url + " iad", url + " ams", url + " iad"}
+			if !slices.Equal(checked, want) {
+				return fmt.Errorf("expected the checks %v, got %v", want, checked)
+			}
+			paths := []*tftypes.AttributePath{
+				tftypes.NewAttributePath().WithAttributeName("status_assertions").WithElementKeyInt(0),
+				tftypes.NewAttributePath().WithAttributeName("json_body_assertions").WithElementKeyInt(0),
+			}
+			if len(warnings) != 2*len(paths) {
+				return fmt.Errorf("expected %d warnings, got %d", 2*len(paths), len(warnings))
+			}
+			for i, warning := range warnings {
+				if warning.Summary != "Assertion fails" || !warning.Attribute.Equal(paths[i%len(paths)]) ||
+					!strings.Contains(warning.Detail, "An ad-hoc check of "+url+" fails") ||
+					!strings.Contains(warning.Detail, "\nams: ") || !strings.Contains(warning.Detail, "\niad: ") {
+					return fmt.Errorf("unexpected warning %d at %s: %s: %s", i, warning.Attribute, warning.Summary, warning.Detail)
+				}
+			}
+			checked, warnings = nil, nil
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: map[string]func() (tfprotov6.ProviderServer, error){
+			"openstatus": func() (tfprotov6.ProviderServer, error) {
+				server, err := providerserver.NewProtocol6WithError(New()())()
+				return testAccPlanWarnings{ProviderServer: server, warnings: &warnings}, err
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				// Failing assertions warn, the monitor is still created.
+				Config: config("https://example.com/health"),
+				Check:  checks("https://example.com/health"),
+			},
+			{
+				// Nothing changed, nothing to check.
+				Config: config("https://example.com/health"),
+				Check: func(*terraform.State) error {
+					if len(checked) != 0 || len(warnings) != 0 {
+						return fmt.Errorf("unexpected checks %v and %d warnings", checked, len(warnings))
+					}
+					return nil
+				},
+			},
+			{
+				Config: config("https://example.com/ready"),
+				Check:  checks("https://example.com/ready"),
+			},
+		},
+	})
+}
+
+func TestAccMonitorResourceRegions(t *testing.T) {
+	server := testAccServer(t)
+
+	config := testAccConfig(server, `
+resource "openstatus_monitor" "test" {
+  name            = "test-monitor-terraform-api"
+  url             = "https://example.com/health"
+  periodicity     = "5m"
+  regions         = ["syd", "europe"]
+  exclude_regions = ["lhr", "otp"]
+}
+`)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "regions.#", "2"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "regions.1", "europe"),
+					func(s *terraform.State) error {
+						id, err := testAccId(s, "openstatus_monitor.test")
+						if err != nil {
+							return err
+						}
+						m, _ := server.Monitors.Get(id)
+						want := []string{"ams", "arn", "cdg", "fra", "mad", "syd", "waw"}
+						if !slices.Equal(m.Regions, want) {
+							return fmt.Errorf("got regions %v, want %v", m.Regions, want)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// Removed from the group in the dashboard: the plan adds it back.
+				PreConfig: func() {
+					m, _ := server.Monitors.Get(1)
+					m.Regions = []string{"ams", "arn", "cdg", "fra", "syd", "waw"}
+					server.Monitors.Put(1, m)
+				},
+				Config: config,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_monitor.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("openstatus_monitor.test", "regions.1", "europe"),
+			},
+		},
+	})
+}
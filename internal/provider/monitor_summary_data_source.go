@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/datasource_monitor_summary"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*monitorSummaryDataSource)(nil)
+
+var (
+	defaultUptimeWindows = []int64{7, 30, 90}
+	defaultSloWindow     = int64(30)
+)
+
+func NewMonitorSummaryDataSource() datasource.DataSource {
+	return &monitorSummaryDataSource{}
+}
+
+type monitorSummaryDataSource struct {
+	client *client.Client
+}
+
+func (d *monitorSummaryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	d.client = config.client
+}
+
+func (d *monitorSummaryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_summary"
+}
+
+func (d *monitorSummaryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_monitor_summary.MonitorSummaryDataSourceSchema(ctx)
+}
+
+func (d *monitorSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_monitor_summary.MonitorSummaryModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windows := defaultUptimeWindows
+	if !data.Windows.IsNull() {
+		resp.Diagnostics.Append(data.Windows.ElementsAs(ctx, &windows, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	summary, err := d.client.GetMonitorSummary(ctx, data.MonitorId.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading monitor summary", apiErrorDetail("Could not read the monitor summary", err))
+		return
+	}
+
+	days := make([]client.MonitorSummaryDay, len(summary.Data))
+	copy(days, summary.Data)
+	sort.SliceStable(days, func(i, j int) bool {
+		return days[i].Day > days[j].Day
+	})
+
+	daysTF := make([]datasource_monitor_summary.DaysModel, 0, len(days))
+	for _, day := range days {
+		daysTF = append(daysTF, datasource_monitor_summary.DaysModel{
+			Count: types.Int64Value(day.Count),
+			Day:   types.StringValue(day.Day),
+			Ok:    types.Int64Value(day.Ok),
+		})
+	}
+	var diags diag.Diagnostics
+	data.Days, diags = types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: datasource_monitor_summary.DaysModel{}.AttributeTypes(ctx),
+	}, daysTF)
+	resp.Diagnostics.Append(diags...)
+
+	today := time.Now().UTC()
+	uptime := make(map[string]attr.Value, len(windows))
+	for _, window := range windows {
+		ok, count := summarize(days, window, today)
+		if count == 0 {
+			uptime[strconv.FormatInt(window, 10)] = types.Float64Null()
+			continue
+		}
+		uptime[strconv.FormatInt(window, 10)] = types.Float64Value(float64(ok) / float64(count) * 100)
+	}
+	data.Uptime, diags = types.MapValue(types.Float64Type, uptime)
+	resp.Diagnostics.Append(diags...)
+
+	data.ErrorBudgetRemaining = types.Float64Null()
+	if !data.SloTarget.IsNull() {
+		window := defaultSloWindow
+		if !data.SloWindow.IsNull() {
+			window = data.SloWindow.ValueInt64()
+		}
+		ok, count := summarize(days, window, today)
+		remaining, err := errorBudgetRemaining(ok, count, data.SloTarget.ValueFloat64())
+		if err != nil {
+			resp.Diagnostics.AddAttributeWarning(path.Root("error_budget_remaining"), "Error budget undefined",
+				fmt.Sprintf("Over the last %d days: %s, error_budget_remaining is null.", window, err))
+		} else {
+			data.ErrorBudgetRemaining = types.Float64Value(remaining)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// summarize adds up the checks of the window days ending today. Days the
+// API has no row for, such as while the monitor is paused, count as no
+// checks, and rows whose day cannot be parsed are ignored.
+func summarize(days []client.MonitorSummaryDay, window int64, today time.Time) (ok int64, count int64) {
+	today = today.UTC().Truncate(24 * time.Hour)
+	cutoff := today.AddDate(0, 0, -int(window))
+	for _, day := range days {
+		date, err := parseSummaryDay(day.Day)
+		if err != nil || !date.After(cutoff) || date.After(today) {
+			continue
+		}
+		ok += day.Ok
+		count += day.Count
+	}
+	return ok, count
+}
+
+// parseSummaryDay reads the date of a summary row, such as 2024-03-01 or
+// 2024-03-01 00:00:00.
+func parseSummaryDay(day string) (time.Time, error) {
+	if len(day) > len(time.DateOnly) {
+		day = day[:len(time.DateOnly)]
+	}
+	return time.Parse(time.DateOnly, day)
+}
+
+// errorBudgetRemaining returns the percentage of failed checks still allowed
+// by the target: 100 with no failure, 0 when the budget is spent and
+// negative beyond. It fails when the budget is undefined: without checks, or
+// with a 100% target, which allows no failure at all, and failed checks.
+func errorBudgetRemaining(ok, count int64, target float64) (float64, error) {
+	if count == 0 {
+		return 0, errors.New("no checks were run")
+	}
+	failed := float64(count - ok)
+	if failed == 0 {
+		return 100, nil
+	}
+	allowed := float64(count) * (100 - target) / 100
+	if allowed == 0 {
+		return 0, fmt.Errorf("%.0f checks failed and a %g%% target allows none", failed, target)
+	}
+	return (allowed - failed) / allowed * 100, nil
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+func TestSummarize(t *testing.T) {
+	today := time.Date(2024, 3, 10, 15, 4, 0, 0, time.UTC)
+	day := func(date string, ok, count int64) client.MonitorSummaryDay {
+		return client.MonitorSummaryDay{Day: date, Ok: ok, Count: count}
+	}
+
+	tests := []struct {
+		name      string
+		days      []client.MonitorSummaryDay
+		window    int64
+		ok, count int64
+	}{
+		{
+			name:   "every day",
+			days:   []client.MonitorSummaryDay{day("2024-03-10", 10, 10), day("2024-03-09", 8, 10), day("2024-03-08", 5, 10)},
+			window: 2,
+			ok:     18, count: 20,
+		},
+		{
+			// Paused from the 4th to the 8th: the 7 days only hold 3 rows.
+			name:   "gap",
+			days:   []client.MonitorSummaryDay{day("2024-03-10", 10, 10), day("2024-03-09", 10, 10), day("2024-03-04", 10, 10), day("2024-03-03", 0, 10), day("2024-03-01", 0, 10)},
+			window: 7,
+			ok:     30, count: 30,
+		},
+		{
+			name:   "window longer than the data",
+			days:   []client.MonitorSummaryDay{day("2024-03-10", 9, 10), day("2024-03-09", 9, 10)},
+			window: 90,
+			ok:     18, count: 20,
+		},
+		{
+			name:   "only old data",
+			days:   []client.MonitorSummaryDay{day("2024-02-01", 9, 10)},
+			window: 7,
+		},
+		{
+			name:   "timestamps and unparseable days",
+			days:   []client.MonitorSummaryDay{day("2024-03-10 00:00:00", 5, 5), day("2024-03-09T00:00:00Z", 5, 5), day("", 10, 10)},
+			window: 7,
+			ok:     10, count: 10,
+		},
+		{
+			name:   "no data",
+			window: 30,
+		},
+	}
+
+	for _, test := range tests {
+		ok, count := summarize(test.days, test.window, today)
+		if ok != test.ok || count != test.count {
+			t.Errorf("%s: got %d/%d, want %d/%d", test.name, ok, count, test.ok, test.count)
+		}
+	}
+}
+
+func TestErrorBudgetRemaining(t *testing.T) {
+	tests := []struct {
+		name      string
+		ok, count int64
+		target    float64
+		remaining float64
+		undefined bool
+	}{
+		{name: "no failure", ok: 1000, count: 1000, target: 99.9, remaining: 100},
+		{name: "half spent", ok: 9995, count: 10000, target: 99.9, remaining: 50},
+		{name: "spent", ok: 999, count: 1000, target: 99.9, remaining: 0},
+		{name: "overspent", ok: 997, count: 1000, target: 99.9, remaining: -200},
+		{name: "100% target without failure", ok: 1000, count: 1000, target: 100, remaining: 100},
+		{name: "100% target with a failure", ok: 999, count: 1000, target: 100, undefined: true},
+		{name: "no checks", target: 99.9, undefined: true},
+		{name: "no checks with a 100% target", target: 100, undefined: true},
+	}
+
+	for _, test := range tests {
+		remaining, err := errorBudgetRemaining(test.ok, test.count, test.target)
+		switch {
+		case test.undefined && err == nil:
+			t.Errorf("%s: expected an error, got %v", test.name, remaining)
+		case !test.undefined && err != nil:
+			t.Errorf("%s: unexpected error %v", test.name, err)
+		case !test.undefined && math.Abs(remaining-test.remaining) > 1e-6:
+			t.Errorf("%s: got %v, want %v", test.name, remaining, test.remaining)
+		}
+	}
+}
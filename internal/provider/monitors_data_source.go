@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"slices"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/datasource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*monitorsDataSource)(nil)
+
+func NewMonitorsDataSource() datasource.DataSource {
+	return &monitorsDataSource{}
+}
+
+type monitorsDataSource struct {
+	client *client.Client
+}
+
+func (d *monitorsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, _ *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	d.client = config.client
+}
+
+func (d *monitorsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitors"
+}
+
+func (d *monitorsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_monitor.MonitorsDataSourceSchema(ctx)
+}
+
+func (d *monitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_monitor.MonitorsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	monitors, err := d.client.ListMonitors(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing monitors", apiErrorDetail("Could not list the monitors", err))
+		return
+	}
+
+	ids := []int64{}
+	models := []datasource_monitor.MonitorModel{}
+	for i := range monitors {
+		monitor := &monitors[i]
+		switch {
+		case !data.Type.IsNull() && monitor.Type != data.Type.ValueString():
+			continue
+		case !data.Active.IsNull() && monitor.Active != data.Active.ValueBool():
+			continue
+		case !data.Public.IsNull() && monitor.Public != data.Public.ValueBool():
+			continue
+		case !data.Region.IsNull() && !slices.Contains(monitor.Regions, data.Region.ValueString()):
+			continue
+		case nameRegex != nil && !nameRegex.MatchString(monitor.Name):
+			continue
+		}
+
+		var model datasource_monitor.MonitorModel
+		resp.Diagnostics.Append(bindMonitorData(ctx, &model, monitor)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ids = append(ids, monitor.Id)
+		models = append(models, model)
+	}
+
+	var diags diag.Diagnostics
+	data.Ids, diags = int64sToList(ctx, ids)
+	resp.Diagnostics.Append(diags...)
+	data.Monitors, diags = types.ListValueFrom(ctx, datasource_monitor.MonitorsType(ctx), models)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
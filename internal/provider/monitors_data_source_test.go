@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMonitorsDataSource(t *testing.T) {
+	server := testAccServer(t)
+	server.Monitors.Put(1, client.MonitorRequest{
+		Id: 1, Name: "test-monitor-terraform-api", Url: "https://example.com/health", Periodicity: "5m", Type: "http",
+		Active: true, Public: true, Regions: []string{"ams", "iad"},
+		Assertions: []client.Assertion{{Status: &client.StatusAssertion{Compare: "eq", Target: 200}}},
+	})
+	server.Monitors.Put(2, client.MonitorRequest{
+		Id: 2, Name: "test-monitor-terraform-db", Url: "db.example.com:5432", Periodicity: "1m", Type: "tcp", Active: true, Regions: []string{"fra"},
+	})
+	server.Monitors.Put(3, client.MonitorRequest{
+		Id: 3, Name: "other", Url: "https://example.org", Periodicity: "10m", Type: "http", Regions: []string{"ams"},
+	})
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "openstatus_monitors" "all" {}
+
+data "openstatus_monitors" "acc" {
+  name_regex = "^test-monitor-terraform-"
+  active     = true
+}
+
+data "openstatus_monitors" "http_ams" {
+  type   = "http"
+  region = "ams"
+}
+
+data "openstatus_monitors" "public" {
+  public = true
+}
+
+data "openstatus_monitors" "none" {
+  name_regex = "^missing"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openstatus_monitors.all", "ids.#", "3"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.all", "monitors.#", "3"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.acc", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.acc", "ids.0", "1"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.acc", "ids.1", "2"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.acc", "monitors.1.name", "test-monitor-terraform-db"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.acc", "monitors.1.type", "tcp"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.acc", "monitors.1.regions.0", "fra"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.http_ams", "ids.#", "2"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.http_ams", "monitors.1.name", "other"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.public", "ids.#", "1"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.public", "monitors.0.url", "https://example.com/health"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.public", "monitors.0.status_assertions.0.compare", "eq"),
+					resource.TestCheckNoResourceAttr("data.openstatus_monitors.public", "monitors.0.exclude_regions"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.none", "ids.#", "0"),
+					resource.TestCheckResourceAttr("data.openstatus_monitors.none", "monitors.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_notification"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                     = (*notificationResource)(nil)
+	_ resource.ResourceWithConfigValidators = (*notificationResource)(nil)
+)
+
+func NewNotificationResource() resource.Resource {
+	return &notificationResource{}
+}
+
+type notificationResource struct {
+	client *client.Client
+}
+
+func (r *notificationResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	r.client = config.client
+}
+
+func (r *notificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+func (r *notificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_notification.NotificationResourceSchema(ctx)
+}
+
+func (r *notificationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("discord"),
+			path.MatchRoot("email"),
+			path.MatchRoot("pagerduty"),
+			path.MatchRoot("slack"),
+			path.MatchRoot("sms"),
+		),
+	}
+}
+
+func (r *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_notification.NotificationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitors, diags := int64sFromList(ctx, data.Monitors)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	provider, payload := notificationPayload(data)
+	out, err := r.client.CreateNotification(ctx, client.NotificationRequest{
+		Name:     data.Name.ValueString(),
+		Provider: provider,
+		Payload:  payload,
+		Monitors: monitors,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating notification", apiErrorDetail("Could not create the notification", err))
+		return
+	}
+
+	resp.Diagnostics.Append(bindNotification(ctx, &data, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *notificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_notification.NotificationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, err := r.client.GetNotification(ctx, data.Id.String())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading notification", apiErrorDetail("Could not read the notification", err))
+		return
+	}
+
+	resp.Diagnostics.Append(bindNotification(ctx, &data, notification)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update is never expected to change anything: the API cannot update a
+// notification, so every configurable attribute requires a replacement.
+func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_notification.NotificationModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only forgets the notification: the OpenStatus API has no endpoint to
+// delete a notification, so it has to be removed from the dashboard.
+func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_notification.NotificationModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning("Notification not deleted",
+		"The OpenStatus API does not support deleting notifications. The notification "+data.Name.ValueString()+
+			" has been removed from the Terraform state but still exists, delete it from the dashboard.")
+}
+
+func notificationPayload(data resource_notification.NotificationModel) (string, client.NotificationPayload) {
+	switch {
+	case data.Discord != nil:
+		return "discord", client.NotificationPayload{Discord: data.Discord.WebhookUrl.ValueString()}
+	case data.Email != nil:
+		return "email", client.NotificationPayload{Email: data.Email.Address.ValueString()}
+	case data.Pagerduty != nil:
+		return "pagerduty", client.NotificationPayload{Pagerduty: data.Pagerduty.IntegrationKey.ValueString()}
+	case data.Slack != nil:
+		return "slack", client.NotificationPayload{Slack: data.Slack.WebhookUrl.ValueString()}
+	case data.Sms != nil:
+		return "sms", client.NotificationPayload{Sms: data.Sms.PhoneNumber.ValueString()}
+	}
+	return "", client.NotificationPayload{}
+}
+
+func bindNotification(ctx context.Context, data *resource_notification.NotificationModel, notification *client.NotificationRequest) diag.Diagnostics {
+	data.Id = types.NumberValue(big.NewFloat(float64(notification.Id)))
+	data.Name = types.StringValue(notification.Name)
+	data.ProviderType = types.StringValue(notification.Provider)
+
+	data.Discord, data.Email, data.Pagerduty, data.Slack, data.Sms = nil, nil, nil, nil, nil
+	switch notification.Provider {
+	case "discord":
+		data.Discord = &resource_notification.DiscordModel{WebhookUrl: types.StringValue(notification.Payload.Discord)}
+	case "email":
+		data.Email = &resource_notification.EmailModel{Address: types.StringValue(notification.Payload.Email)}
+	case "pagerduty":
+		data.Pagerduty = &resource_notification.PagerdutyModel{IntegrationKey: types.StringValue(notification.Payload.Pagerduty)}
+	case "slack":
+		data.Slack = &resource_notification.SlackModel{WebhookUrl: types.StringValue(notification.Payload.Slack)}
+	case "sms":
+		data.Sms = &resource_notification.SmsModel{PhoneNumber: types.StringValue(notification.Payload.Sms)}
+	}
+
+	var diags diag.Diagnostics
+	data.Monitors, diags = int64sToList(ctx, notification.Monitors)
+	return diags
+}
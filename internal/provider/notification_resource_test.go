@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccNotificationResource(t *testing.T) {
+	server := testAccServer(t)
+
+	config := func(address string) string {
+		return testAccConfig(server, fmt.Sprintf(`
+resource "openstatus_monitor" "api" {
+  name        = "test-monitor-terraform-api"
+  url         = "https://example.com"
+  periodicity = "10m"
+}
+
+resource "openstatus_notification" "test" {
+  name     = "test-monitor-terraform-on-call"
+  monitors = [openstatus_monitor.api.id]
+  email {
+    address = %q
+  }
+}
+`, address))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+resource "openstatus_notification" "test" {
+  name = "test-monitor-terraform-on-call"
+  email {}
+}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Attribute "email.address" must be specified when "email" is\s+specified`),
+			},
+			{
+				Config: config("oncall@example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_notification.test", "id", "1"),
+					resource.TestCheckResourceAttr("openstatus_notification.test", "provider_type", "email"),
+					resource.TestCheckResourceAttr("openstatus_notification.test", "monitors.#", "1"),
+				),
+			},
+			{
+				// The API cannot update a notification, it is replaced.
+				Config: config("sre@example.com"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_notification.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					id, err := testAccId(s, "openstatus_notification.test")
+					if err != nil {
+						return err
+					}
+					if notification, _ := server.Notifications.Get(id); notification.Payload.Email != "sre@example.com" {
+						return fmt.Errorf("replacement not sent to the API: %+v", notification)
+					}
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					server.Notifications.Delete(2)
+				},
+				Config: config("sre@example.com"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_notification.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("openstatus_notification.test", "id", "3"),
+			},
+		},
+	})
+}
@@ -0,0 +1,157 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_page_subscribers"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*pageSubscribersResource)(nil)
+
+func NewPageSubscribersResource() resource.Resource {
+	return &pageSubscribersResource{}
+}
+
+// pageSubscribersResource pushes a list of email subscribers to a status
+// page. The API can only add subscribers: it cannot list or remove them, so
+// the state holds the addresses this resource subscribed.
+type pageSubscribersResource struct {
+	client *client.Client
+}
+
+func (r *pageSubscribersResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	r.client = config.client
+}
+
+func (r *pageSubscribersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_page_subscribers"
+}
+
+func (r *pageSubscribersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_page_subscribers.PageSubscribersResourceSchema(ctx)
+}
+
+func (r *pageSubscribersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_page_subscribers.PageSubscribersModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var emails []string
+	resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &emails, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscribed, diags := r.subscribe(ctx, data.PageId.String(), emails)
+	resp.Diagnostics.Append(diags...)
+
+	var setDiags diag.Diagnostics
+	data.Emails, setDiags = types.SetValueFrom(ctx, types.StringType, subscribed)
+	resp.Diagnostics.Append(setDiags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as it is, the API has no way to list the subscribers
+// of a page. It only checks that the page still exists.
+func (r *pageSubscribersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_page_subscribers.PageSubscribersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetPage(ctx, data.PageId.String())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading status page", apiErrorDetail("Could not read the status page", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pageSubscribersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var before, data resource_page_subscribers.PageSubscribersModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &before)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current, wanted []string
+	resp.Diagnostics.Append(before.Emails.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(data.Emails.ElementsAs(ctx, &wanted, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	known := make(map[string]bool, len(current))
+	for _, email := range current {
+		known[email] = true
+	}
+	var kept, added []string
+	for _, email := range wanted {
+		if known[email] {
+			kept = append(kept, email)
+			delete(known, email)
+		} else {
+			added = append(added, email)
+		}
+	}
+	if len(known) > 0 {
+		removed := make([]string, 0, len(known))
+		for email := range known {
+			removed = append(removed, email)
+		}
+		resp.Diagnostics.AddWarning("Subscribers not removed",
+			"The OpenStatus API does not support removing page subscribers. The following addresses are still subscribed: "+
+				strings.Join(removed, ", "))
+	}
+
+	subscribed, diags := r.subscribe(ctx, data.PageId.String(), added)
+	resp.Diagnostics.Append(diags...)
+
+	var setDiags diag.Diagnostics
+	data.Emails, setDiags = types.SetValueFrom(ctx, types.StringType, append(kept, subscribed...))
+	resp.Diagnostics.Append(setDiags...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pageSubscribersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	resp.Diagnostics.AddWarning("Subscribers not removed",
+		"The OpenStatus API does not support removing page subscribers. They have been removed from the Terraform state but are still subscribed to the page.")
+}
+
+// subscribe adds every email to the page and returns the ones that were
+// subscribed. Each failure is reported against its address so one bad email
+// does not hide the others.
+func (r *pageSubscribersResource) subscribe(ctx context.Context, pageId string, emails []string) ([]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	subscribed := make([]string, 0, len(emails))
+	for _, email := range emails {
+		_, err := r.client.UpdatePageSubscriber(ctx, client.PageSubscriberRequest{Email: email}, pageId)
+		if err != nil {
+			diags.AddAttributeError(path.Root("emails").AtSetValue(types.StringValue(email)),
+				"Error subscribing to page", apiErrorDetail("Could not subscribe "+email+" to the page", err))
+			continue
+		}
+		subscribed = append(subscribed, email)
+	}
+	return subscribed, diags
+}
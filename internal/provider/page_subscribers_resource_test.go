@@ -0,0 +1,66 @@
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccPageSubscribersResource(t *testing.T) {
+	server := testAccServer(t)
+
+	config := func(emails string) string {
+		return testAccConfig(server, fmt.Sprintf(`
+resource "openstatus_status_page" "page" {
+  title = "test-monitor-terraform-status"
+  slug  = "acme-status"
+}
+
+resource "openstatus_page_subscribers" "test" {
+  page_id = openstatus_status_page.page.id
+  emails  = %s
+}
+`, emails))
+	}
+
+	subscribed := func(want ...string) resource.TestCheckFunc {
+		return func(s *terraform.State) error {
+			emails, _ := server.PageSubscribers.Get(1)
+			for _, email := range want {
+				if !slices.Contains(emails, email) {
+					return fmt.Errorf("%s not subscribed, got %v", email, emails)
+				}
+			}
+			return nil
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(`["ada@example.com"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_page_subscribers.test", "emails.#", "1"),
+					subscribed("ada@example.com"),
+				),
+			},
+			{
+				Config: config(`["ada@example.com", "grace@example.com"]`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_page_subscribers.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_page_subscribers.test", "emails.#", "2"),
+					subscribed("ada@example.com", "grace@example.com"),
+				),
+			},
+		},
+	})
+}
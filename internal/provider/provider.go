@@ -2,14 +2,23 @@ package provider
 
 import (
 	"context"
+	"os"
+	"strconv"
+	"time"
 
-	hreq "github.com/imroc/req/v3"
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ provider.Provider = (*openstatusProvider)(nil)
@@ -21,24 +30,69 @@ func New() func() provider.Provider {
 }
 
 type ProviderConfig struct {
-	client *hreq.Client
+	client *client.Client
+	// checkAssertions runs the assertions of http monitors against an ad-hoc
+	// check during plan.
+	checkAssertions bool
 }
 
 type openstatusProvider struct {
-	client *hreq.Client
+	client *client.Client
 	token  string
 }
 
 type openStatusProviderData struct {
-	OpenStatusToken types.String `tfsdk:"openstatus_api_token"`
+	Endpoint         types.String `tfsdk:"endpoint"`
+	OpenStatusToken  types.String `tfsdk:"openstatus_api_token"`
+	RetryMaxAttempts types.Int64  `tfsdk:"retry_max_attempts"`
+	RetryBaseBackoff types.String `tfsdk:"retry_base_backoff"`
+	RetryMaxBackoff  types.String `tfsdk:"retry_max_backoff"`
+	CassetteMode     types.String `tfsdk:"cassette_mode"`
+	CassetteDir      types.String `tfsdk:"cassette_dir"`
+	CheckAssertions  types.Bool   `tfsdk:"check_assertions"`
 }
 
 func (p *openstatusProvider) Schema(ctx context.Context, req provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the OpenStatus API, for self-hosted instances. Can also be set with the `OPENSTATUS_ENDPOINT` environment variable. Defaults to `" + client.DefaultEndpoint + "`.",
+				Optional:            true,
+			},
 			"openstatus_api_token": schema.StringAttribute{
-				MarkdownDescription: "openstatus.dev api token.",
-				Required:            true,
+				MarkdownDescription: "openstatus.dev api token. Can also be set with the `OPENSTATUS_API_TOKEN` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"retry_max_attempts": schema.Int64Attribute{
+				MarkdownDescription: "How many times a request is attempted before giving up, the first attempt included. Idempotent requests are retried on network errors and 5xx responses, every request is retried on 429. Defaults to 4, 1 disables retries.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"retry_base_backoff": schema.StringAttribute{
+				MarkdownDescription: "Wait before the first retry, doubled on each retry, such as `500ms` or `2s`. Defaults to `1s`.",
+				Optional:            true,
+			},
+			"retry_max_backoff": schema.StringAttribute{
+				MarkdownDescription: "Longest wait between two retries, unless the API sends a longer `Retry-After`. Defaults to `30s`.",
+				Optional:            true,
+			},
+			"cassette_mode": schema.StringAttribute{
+				MarkdownDescription: "Set to `record` to write every API exchange to a cassette in `cassette_dir`, with the API key and other sensitive headers redacted, as well as the secrets of the bodies: status page passwords, notification webhook URLs and integration keys, and monitor headers such as `Authorization`. Replayed responses hold `REDACTED` in their place. Set to `replay` to answer the requests from the cassettes of `cassette_dir` instead of calling the API, no API token is needed then. Replay continues across Terraform runs where the previous run stopped, the progress is kept in the `replay-progress` file of `cassette_dir`: delete it to replay from the start, recording again also deletes it. Can also be set with the `OPENSTATUS_CASSETTE_MODE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("record", "replay"),
+				},
+			},
+			"cassette_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory of the cassettes used by `cassette_mode`. Can also be set with the `OPENSTATUS_CASSETTE_DIR` environment variable.",
+				Optional:            true,
+			},
+			"check_assertions": schema.BoolAttribute{
+				MarkdownDescription: "Set to `true` to run an ad-hoc check of every created or changed http monitor during plan, and warn about the assertions its response fails, before the monitor alerts. As Terraform plans again during apply, the check of an applied change runs twice. Can also be set with the `OPENSTATUS_CHECK_ASSERTIONS` environment variable. Defaults to `false`.",
+				Optional:            true,
 			},
 		},
 	}
@@ -52,20 +106,127 @@ func (p *openstatusProvider) Configure(ctx context.Context, req provider.Configu
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	if data.OpenStatusToken.IsUnknown() || data.OpenStatusToken.IsNull() {
-		resp.Diagnostics.AddError("openstatus_api_token is required",
-			"openstatus_api_token is required")
+	if data.OpenStatusToken.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("openstatus_api_token"), "Unknown openstatus_api_token",
+			"openstatus_api_token depends on a value that is not known yet, set it statically or use the OPENSTATUS_API_TOKEN environment variable.")
+	}
+	if data.Endpoint.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(path.Root("endpoint"), "Unknown endpoint",
+			"endpoint depends on a value that is not known yet, set it statically or use the OPENSTATUS_ENDPOINT environment variable.")
+	}
+	if resp.Diagnostics.HasError() {
 		return
 	}
-	token := data.OpenStatusToken.ValueString()
+
+	cassetteMode := os.Getenv("OPENSTATUS_CASSETTE_MODE")
+	if !data.CassetteMode.IsNull() && !data.CassetteMode.IsUnknown() {
+		cassetteMode = data.CassetteMode.ValueString()
+	}
+	cassetteDir := os.Getenv("OPENSTATUS_CASSETTE_DIR")
+	if !data.CassetteDir.IsNull() && !data.CassetteDir.IsUnknown() {
+		cassetteDir = data.CassetteDir.ValueString()
+	}
+	var cassette client.Middleware
+	switch cassetteMode {
+	case "":
+	case "record":
+		cassette = client.Record(cassetteDir)
+	case "replay":
+		var err error
+		cassette, err = client.Replay(cassetteDir)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("cassette_dir"), "Cannot replay cassettes", err.Error())
+		}
+	default:
+		resp.Diagnostics.AddAttributeError(path.Root("cassette_mode"), "Invalid cassette_mode",
+			"Expected record or replay, got: "+cassetteMode)
+	}
+	if cassetteMode != "" && cassetteDir == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("cassette_dir"), "cassette_dir is required",
+			"Set cassette_dir in the provider configuration or the OPENSTATUS_CASSETTE_DIR environment variable to use cassette_mode.")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := os.Getenv("OPENSTATUS_API_TOKEN")
+	if !data.OpenStatusToken.IsNull() {
+		token = data.OpenStatusToken.ValueString()
+	}
+	if token == "" && cassetteMode != "replay" {
+		resp.Diagnostics.AddAttributeError(path.Root("openstatus_api_token"), "openstatus_api_token is required",
+			"Set openstatus_api_token in the provider configuration or the OPENSTATUS_API_TOKEN environment variable.")
+		return
+	}
+
+	endpoint := os.Getenv("OPENSTATUS_ENDPOINT")
+	if !data.Endpoint.IsNull() {
+		endpoint = data.Endpoint.ValueString()
+	}
+	if endpoint == "" {
+		endpoint = client.DefaultEndpoint
+	}
+
+	retryPolicy := client.DefaultRetryPolicy
+	if !data.RetryMaxAttempts.IsNull() && !data.RetryMaxAttempts.IsUnknown() {
+		retryPolicy.MaxAttempts = int(data.RetryMaxAttempts.ValueInt64())
+	}
+	retryPolicy.BaseBackoff = parseDuration(path.Root("retry_base_backoff"), data.RetryBaseBackoff, retryPolicy.BaseBackoff, &resp.Diagnostics)
+	retryPolicy.MaxBackoff = parseDuration(path.Root("retry_max_backoff"), data.RetryMaxBackoff, retryPolicy.MaxBackoff, &resp.Diagnostics)
+	checkAssertions := false
+	if env := os.Getenv("OPENSTATUS_CHECK_ASSERTIONS"); env != "" {
+		var err error
+		if checkAssertions, err = strconv.ParseBool(env); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("check_assertions"), "Invalid OPENSTATUS_CHECK_ASSERTIONS",
+				"Expected true or false, got: "+env)
+		}
+	}
+	if !data.CheckAssertions.IsNull() && !data.CheckAssertions.IsUnknown() {
+		checkAssertions = data.CheckAssertions.ValueBool()
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	middleware := []client.Middleware{
+		client.Logging(func(ctx context.Context, msg string, fields map[string]interface{}) {
+			tflog.Debug(ctx, msg, fields)
+		}),
+		client.Retry(retryPolicy, func(ctx context.Context, msg string, fields map[string]interface{}) {
+			tflog.Warn(ctx, msg, fields)
+		}),
+	}
+	// Last, so every attempt is recorded or replayed as sent on the wire.
+	if cassette != nil {
+		middleware = append(middleware, cassette)
+	}
+
 	p.token = token
-	p.client = hreq.C()
-	p.client.SetBaseURL("https://api.openstatus.dev/v1/")
-	p.client.SetCommonHeader("x-openstatus-key", token)
+	p.client = client.New(token,
+		client.WithEndpoint(endpoint),
+		client.WithMiddleware(middleware...),
+	)
 
 	resp.ResourceData = ProviderConfig{
-		client: p.client,
+		client:          p.client,
+		checkAssertions: checkAssertions,
 	}
+	resp.DataSourceData = resp.ResourceData
+}
+
+// parseDuration returns the duration configured in value, or fallback when it
+// is not set.
+func parseDuration(attributePath path.Path, value types.String, fallback time.Duration, diags *diag.Diagnostics) time.Duration {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil || duration < 0 {
+		diags.AddAttributeError(attributePath, "Invalid duration",
+			"Expected a positive duration such as 500ms or 2s, got: "+value.ValueString())
+		return fallback
+	}
+	return duration
 }
 
 func (p *openstatusProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -73,11 +234,22 @@ func (p *openstatusProvider) Metadata(ctx context.Context, req provider.Metadata
 }
 
 func (p *openstatusProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewHttpCheckDataSource,
+		NewMonitorDataSource,
+		NewMonitorSummaryDataSource,
+		NewMonitorsDataSource,
+		NewRegionsDataSource,
+	}
 }
 
 func (p *openstatusProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewIncidentResource,
 		NewMonitorResource,
+		NewNotificationResource,
+		NewPageSubscribersResource,
+		NewStatusPageResource,
+		NewStatusReportResource,
 	}
 }
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client/fakeapi"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+// The acceptance tests run Terraform against a fake of the OpenStatus API,
+// through the endpoint attribute, so they need no credentials:
+//
+//	TF_ACC=1 go test ./internal/provider -run TestAcc
+//
+// The objects they create are named after defaultSweepPrefix all the same, so
+// the sweepers find them when the tests are pointed at a real workspace.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"openstatus": providerserver.NewProtocol6WithError(New()()),
+}
+
+const testAccToken = "acc-token"
+
+// testAccServer starts a fake OpenStatus API for the duration of the test.
+func testAccServer(t *testing.T) *fakeapi.Server {
+	server := fakeapi.NewServer(testAccToken)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// testAccConfig prefixes config with a provider block using server. Retries
+// are disabled so a failing request fails the step at once.
+func testAccConfig(server *fakeapi.Server, config string) string {
+	return fmt.Sprintf(`
+provider "openstatus" {
+  endpoint             = %q
+  openstatus_api_token = %q
+  retry_max_attempts   = 1
+}
+`, server.Endpoint(), testAccToken) + config
+}
+
+// testAccId returns the id of the resource called name in the state.
+func testAccId(s *terraform.State, name string) (int64, error) {
+	rs, ok := s.RootModule().Resources[name]
+	if !ok {
+		return 0, fmt.Errorf("%s not found in the state", name)
+	}
+	return strconv.ParseInt(rs.Primary.ID, 10, 64)
+}
+
+func TestAccProviderCassette(t *testing.T) {
+	server := testAccServer(t)
+	dir := t.TempDir()
+
+	// The status report sends the current time with each update, so the
+	// replayed session sends other bodies than the recorded one.
+	resources := func(status, message string) string {
+		return fmt.Sprintf(`
+resource "openstatus_monitor" "test" {
+  name        = "test-monitor-terraform-api"
+  url         = "https://example.com"
+  periodicity = "10m"
+}
+
+resource "openstatus_notification" "test" {
+  name     = "test-monitor-terraform-on-call"
+  monitors = [openstatus_monitor.test.id]
+  email {
+    address = "oncall@example.com"
+  }
+}
+
+resource "openstatus_status_report" "test" {
+  title   = "test-monitor-terraform-checkout-errors"
+  status  = %q
+  message = %q
+}
+`, status, message)
+	}
+	recording := fmt.Sprintf(`
+provider "openstatus" {
+  endpoint             = %q
+  openstatus_api_token = %q
+  cassette_mode        = "record"
+  cassette_dir         = %q
+}
+`, server.Endpoint(), testAccToken, dir)
+	// Nothing listens on port 1, every request has to be replayed.
+	replaying := fmt.Sprintf(`
+provider "openstatus" {
+  endpoint           = "http://127.0.0.1:1/v1/"
+  retry_max_attempts = 1
+  cassette_mode      = "replay"
+  cassette_dir       = %q
+}
+`, dir)
+
+	// session creates the resources, updates the status report and destroys
+	// them, so it can be recorded and then replayed.
+	session := func(provider string, check resource.TestCheckFunc) resource.TestCase {
+		return resource.TestCase{
+			ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+			Steps: []resource.TestStep{
+				{
+					Config: provider + resources("investigating", "Looking into it"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("openstatus_notification.test", "email.address", "oncall@example.com"),
+						resource.TestCheckResourceAttr("openstatus_status_report.test", "updates.#", "1"),
+						check,
+					),
+				},
+				{
+					Config: provider + resources("resolved", "Fixed"),
+					Check: resource.ComposeAggregateTestCheckFunc(
+						resource.TestCheckResourceAttr("openstatus_status_report.test", "status", "resolved"),
+						resource.TestCheckResourceAttr("openstatus_status_report.test", "updates.#", "2"),
+					),
+				},
+			},
+		}
+	}
+
+	resource.Test(t, session(recording, func(s *terraform.State) error {
+		files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+		if len(files) == 0 {
+			return fmt.Errorf("no cassette recorded in %s", dir)
+		}
+		for _, file := range files {
+			b, err := os.ReadFile(file)
+			if err != nil {
+				return err
+			}
+			if strings.Contains(string(b), testAccToken) {
+				return fmt.Errorf("the API key was recorded in %s", file)
+			}
+		}
+		return nil
+	}))
+	resource.Test(t, session(replaying, func(*terraform.State) error {
+		if n := server.StatusReports.Len(); n != 0 {
+			return fmt.Errorf("the replay reached the API, %d status reports", n)
+		}
+		return nil
+	}))
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"slices"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/datasource_regions"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_monitor"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = (*regionsDataSource)(nil)
+
+func NewRegionsDataSource() datasource.DataSource {
+	return &regionsDataSource{}
+}
+
+// regionsDataSource lists the regions monitors accept, from the catalog the
+// monitor resource validates against, without calling the API.
+type regionsDataSource struct{}
+
+func (d *regionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_regions"
+}
+
+func (d *regionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = datasource_regions.RegionsDataSourceSchema(ctx)
+}
+
+func (d *regionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data datasource_regions.RegionsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	codes := []string{}
+	data.Regions = []datasource_regions.RegionsValue{}
+	for _, region := range client.Regions {
+		if !data.Continent.IsNull() && !slices.Contains(resource_monitor.RegionGroups[data.Continent.ValueString()], region.Continent) {
+			continue
+		}
+		codes = append(codes, region.Code)
+		data.Regions = append(data.Regions, datasource_regions.RegionsValue{
+			City:      types.StringValue(region.City),
+			Code:      types.StringValue(region.Code),
+			Continent: types.StringValue(region.Continent),
+			Country:   types.StringValue(region.Country),
+		})
+	}
+
+	var diags diag.Diagnostics
+	data.Codes, diags = types.ListValueFrom(ctx, types.StringType, codes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRegionsDataSource(t *testing.T) {
+	server := testAccServer(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(server, `
+data "openstatus_regions" "europe" {
+  continent = "Europe"
+}
+`),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+			{
+				Config: testAccConfig(server, `
+data "openstatus_regions" "all" {}
+
+data "openstatus_regions" "oceania" {
+  continent = "oceania"
+}
+
+data "openstatus_regions" "north_america" {
+  continent = "north-america"
+}
+
+resource "openstatus_monitor" "test" {
+  name        = "test-monitor-terraform-api"
+  url         = "https://example.com/health"
+  periodicity = "5m"
+  regions     = data.openstatus_regions.oceania.codes
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.openstatus_regions.all", "codes.#", "35"),
+					resource.TestCheckResourceAttr("data.openstatus_regions.all", "regions.0.code", "ams"),
+					resource.TestCheckResourceAttr("data.openstatus_regions.all", "regions.0.city", "Amsterdam"),
+					resource.TestCheckResourceAttr("data.openstatus_regions.all", "regions.0.country", "Netherlands"),
+					resource.TestCheckResourceAttr("data.openstatus_regions.all", "regions.0.continent", "Europe"),
+					resource.TestCheckResourceAttr("data.openstatus_regions.oceania", "codes.#", "1"),
+					resource.TestCheckResourceAttr("data.openstatus_regions.north_america", "regions.0.continent", "North America"),
+					resource.TestCheckResourceAttr("openstatus_monitor.test", "regions.0", "syd"),
+				),
+			},
+		},
+	})
+}
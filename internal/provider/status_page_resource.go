@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_status_page"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*statusPageResource)(nil)
+
+func NewStatusPageResource() resource.Resource {
+	return &statusPageResource{}
+}
+
+type statusPageResource struct {
+	client *client.Client
+}
+
+func (r *statusPageResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	r.client = config.client
+}
+
+func (r *statusPageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_page"
+}
+
+func (r *statusPageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_status_page.StatusPageResourceSchema(ctx)
+}
+
+func (r *statusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_status_page.StatusPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := statusPageRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreatePage(ctx, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating status page", apiErrorDetail("Could not create the status page", err))
+		return
+	}
+
+	resp.Diagnostics.Append(bindStatusPage(ctx, &data, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *statusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_status_page.StatusPageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	page, err := r.client.GetPage(ctx, data.Id.String())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading status page", apiErrorDetail("Could not read the status page", err))
+		return
+	}
+
+	resp.Diagnostics.Append(bindStatusPage(ctx, &data, page)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *statusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_status_page.StatusPageModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request, diags := statusPageRequest(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.UpdatePage(ctx, request, data.Id.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating status page", apiErrorDetail("Could not update the status page", err))
+		return
+	}
+
+	resp.Diagnostics.Append(bindStatusPage(ctx, &data, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only forgets the page: the OpenStatus API has no endpoint to delete
+// a status page, so it has to be removed from the dashboard.
+func (r *statusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_status_page.StatusPageModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning("Status page not deleted",
+		"The OpenStatus API does not support deleting status pages. The page "+data.Slug.ValueString()+
+			" has been removed from the Terraform state but still exists, delete it from the dashboard.")
+}
+
+func statusPageRequest(ctx context.Context, data resource_status_page.StatusPageModel) (client.PageRequest, diag.Diagnostics) {
+	monitors, diags := int64sFromList(ctx, data.Monitors)
+
+	return client.PageRequest{
+		Title:             data.Title.ValueString(),
+		Description:       data.Description.ValueString(),
+		Slug:              data.Slug.ValueString(),
+		CustomDomain:      data.CustomDomain.ValueString(),
+		Icon:              data.Icon.ValueString(),
+		PasswordProtected: data.PasswordProtected.ValueBool(),
+		Password:          data.Password.ValueString(),
+		Monitors:          monitors,
+	}, diags
+}
+
+func bindStatusPage(ctx context.Context, data *resource_status_page.StatusPageModel, page *client.PageRequest) diag.Diagnostics {
+	data.Id = types.NumberValue(big.NewFloat(float64(page.Id)))
+	data.Title = types.StringValue(page.Title)
+	data.Description = types.StringValue(page.Description)
+	data.Slug = types.StringValue(page.Slug)
+	data.CustomDomain = types.StringValue(page.CustomDomain)
+	data.Icon = types.StringValue(page.Icon)
+	data.PasswordProtected = types.BoolValue(page.PasswordProtected)
+	// The API does not always echo the password back, keep the configured one.
+	if page.Password != "" {
+		data.Password = types.StringValue(page.Password)
+	}
+
+	var diags diag.Diagnostics
+	data.Monitors, diags = int64sToList(ctx, page.Monitors)
+	return diags
+}
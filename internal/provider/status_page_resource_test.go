@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStatusPageResource(t *testing.T) {
+	server := testAccServer(t)
+
+	config := func(description string) string {
+		return testAccConfig(server, fmt.Sprintf(`
+resource "openstatus_monitor" "api" {
+  name        = "test-monitor-terraform-api"
+  url         = "https://example.com"
+  periodicity = "10m"
+}
+
+resource "openstatus_status_page" "test" {
+  title       = "test-monitor-terraform-status"
+  slug        = "acme-status"
+  description = %q
+  monitors    = [openstatus_monitor.api.id]
+}
+`, description))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			// The API cannot delete pages, destroying only forgets them.
+			if n := server.Pages.Len(); n != 1 {
+				return fmt.Errorf("expected the page to be kept, found %d pages", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("Everything about Acme"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_status_page.test", "id", "1"),
+					resource.TestCheckResourceAttr("openstatus_status_page.test", "monitors.#", "1"),
+					resource.TestCheckResourceAttr("openstatus_status_page.test", "password_protected", "false"),
+				),
+			},
+			{
+				Config: config("All Acme services"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_status_page.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					if page, _ := server.Pages.Get(1); page.Description != "All Acme services" {
+						return fmt.Errorf("update not sent to the API: %+v", page)
+					}
+					return nil
+				},
+			},
+			{
+				PreConfig: func() {
+					page, _ := server.Pages.Get(1)
+					page.Title = "Renamed in the dashboard"
+					server.Pages.Put(1, page)
+				},
+				Config: config("All Acme services"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_status_page.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: func(s *terraform.State) error {
+					if page, _ := server.Pages.Get(1); page.Title != "test-monitor-terraform-status" {
+						return fmt.Errorf("drift not corrected: %+v", page)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"time"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+	"github.com/openstatusHQ/terraform-provider-openstatus/internal/resource_status_report"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = (*statusReportResource)(nil)
+
+func NewStatusReportResource() resource.Resource {
+	return &statusReportResource{}
+}
+
+type statusReportResource struct {
+	client *client.Client
+}
+
+func (r *statusReportResource) Configure(_ context.Context, req resource.ConfigureRequest, _ *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	config := req.ProviderData.(ProviderConfig)
+	r.client = config.client
+}
+
+func (r *statusReportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_status_report"
+}
+
+func (r *statusReportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = resource_status_report.StatusReportResourceSchema(ctx)
+}
+
+func (r *statusReportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data resource_status_report.StatusReportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorIds, diags := int64sFromList(ctx, data.MonitorIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	pageId := int64Value(data.PageId)
+
+	out, err := r.client.CreateStatusReport(ctx, client.StatusReportRequest{
+		Title:      data.Title.ValueString(),
+		Status:     data.Status.ValueString(),
+		Message:    data.Message.ValueString(),
+		Date:       time.Now().UTC().Format(time.RFC3339),
+		MonitorIds: monitorIds,
+		PageId:     pageId,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating status report", apiErrorDetail("Could not create the status report", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.bindStatusReport(ctx, &data, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *statusReportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data resource_status_report.StatusReportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	report, err := r.client.GetStatusReport(ctx, data.Id.String())
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading status report", apiErrorDetail("Could not read the status report", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.bindStatusReport(ctx, &data, report)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update posts a new status report update, which is how the API changes the
+// status and message of an existing report. Every other attribute requires a
+// replacement.
+func (r *statusReportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data resource_status_report.StatusReportModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, _ := data.Id.ValueBigFloat().Int64()
+	out, err := r.client.UpdateStatusReport(ctx, client.StatusReportUpdateRequest{
+		Status:         data.Status.ValueString(),
+		Date:           time.Now().UTC().Format(time.RFC3339),
+		Message:        data.Message.ValueString(),
+		StatusReportId: id,
+	}, data.Id.String())
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating status report", apiErrorDetail("Could not post the status report update", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.bindStatusReport(ctx, &data, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *statusReportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data resource_status_report.StatusReportModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteStatusReport(ctx, data.Id.String())
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Error deleting status report", apiErrorDetail("Could not delete the status report", err))
+		return
+	}
+}
+
+// bindStatusReport copies the report into data and fetches its updates to
+// build the history. The API does not return the report message, the latest
+// update carries it.
+func (r *statusReportResource) bindStatusReport(ctx context.Context, data *resource_status_report.StatusReportModel, report *client.StatusReportRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.NumberValue(big.NewFloat(float64(report.Id)))
+	data.Title = types.StringValue(report.Title)
+	data.Status = types.StringValue(report.Status)
+	if report.PageId != 0 {
+		data.PageId = types.NumberValue(big.NewFloat(float64(report.PageId)))
+	} else {
+		data.PageId = types.NumberNull()
+	}
+
+	data.MonitorIds, diags = int64sToList(ctx, report.MonitorIds)
+	if diags.HasError() {
+		return diags
+	}
+
+	updates := make([]resource_status_report.UpdatesModel, 0, len(report.StatusReportUpdateIds))
+	for _, updateId := range report.StatusReportUpdateIds {
+		update, err := r.client.GetStatusReportUpdate(ctx, strconv.FormatInt(updateId, 10))
+		if err != nil {
+			diags.AddError("Error reading status report update", apiErrorDetail("Could not read the status report update", err))
+			return diags
+		}
+		updates = append(updates, resource_status_report.UpdatesModel{
+			Date:    types.StringValue(update.Date),
+			Id:      types.StringValue(strconv.FormatInt(updateId, 10)),
+			Message: types.StringValue(update.Message),
+			Status:  types.StringValue(update.Status),
+		})
+	}
+	if len(updates) > 0 {
+		data.Message = updates[len(updates)-1].Message
+	}
+
+	data.Updates, diags = types.ListValueFrom(ctx, types.ObjectType{
+		AttrTypes: resource_status_report.UpdatesModel{}.AttributeTypes(ctx),
+	}, updates)
+	return diags
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStatusReportResource(t *testing.T) {
+	server := testAccServer(t)
+
+	config := func(status, message string) string {
+		return testAccConfig(server, fmt.Sprintf(`
+resource "openstatus_status_report" "test" {
+  title   = "test-monitor-terraform-checkout-errors"
+  status  = %q
+  message = %q
+}
+`, status, message))
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: func(s *terraform.State) error {
+			if n := server.StatusReports.Len(); n != 0 {
+				return fmt.Errorf("%d status reports left in the API", n)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("investigating", "Looking into it"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_status_report.test", "id", "1"),
+					resource.TestCheckResourceAttr("openstatus_status_report.test", "updates.#", "1"),
+					resource.TestCheckResourceAttr("openstatus_status_report.test", "updates.0.message", "Looking into it"),
+				),
+			},
+			{
+				Config: config("resolved", "Fixed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_status_report.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("openstatus_status_report.test", "id", "1"),
+					resource.TestCheckResourceAttr("openstatus_status_report.test", "updates.#", "2"),
+					func(s *terraform.State) error {
+						if report, _ := server.StatusReports.Get(1); report.Status != "resolved" {
+							return fmt.Errorf("update not sent to the API: %+v", report)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				PreConfig: func() {
+					server.StatusReports.Delete(1)
+				},
+				Config: config("resolved", "Fixed"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("openstatus_status_report.test", plancheck.ResourceActionCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("openstatus_status_report.test", "id", "2"),
+			},
+		},
+	})
+}
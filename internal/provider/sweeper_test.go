@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// The sweepers clean up the objects leaked by failed test or demo runs in a
+// real OpenStatus workspace. They only touch objects whose name, or title,
+// starts with OPENSTATUS_SWEEP_PREFIX:
+//
+//	OPENSTATUS_API_TOKEN=... go test ./internal/provider -v -sweep=all
+//
+// The value of -sweep is required by the test framework but unused, the API
+// has no regions to sweep separately.
+const defaultSweepPrefix = "test-monitor-terraform"
+
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func init() {
+	resource.AddTestSweepers("openstatus_monitor", &resource.Sweeper{
+		Name:         "openstatus_monitor",
+		Dependencies: []string{"openstatus_status_report"},
+		F:            sweepMonitors,
+	})
+	resource.AddTestSweepers("openstatus_status_page", &resource.Sweeper{
+		Name: "openstatus_status_page",
+		F:    sweepStatusPages,
+	})
+	resource.AddTestSweepers("openstatus_notification", &resource.Sweeper{
+		Name: "openstatus_notification",
+		F:    sweepNotifications,
+	})
+	resource.AddTestSweepers("openstatus_status_report", &resource.Sweeper{
+		Name: "openstatus_status_report",
+		F:    sweepStatusReports,
+	})
+}
+
+// sweeperClient builds a client from the same environment variables as the
+// provider.
+func sweeperClient() (*client.Client, string, error) {
+	token := os.Getenv("OPENSTATUS_API_TOKEN")
+	if token == "" {
+		return nil, "", errors.New("OPENSTATUS_API_TOKEN must be set to sweep")
+	}
+	endpoint := os.Getenv("OPENSTATUS_ENDPOINT")
+	if endpoint == "" {
+		endpoint = client.DefaultEndpoint
+	}
+	prefix := os.Getenv("OPENSTATUS_SWEEP_PREFIX")
+	if prefix == "" {
+		prefix = defaultSweepPrefix
+	}
+	c := client.New(token,
+		client.WithEndpoint(endpoint),
+		client.WithMiddleware(client.Retry(client.DefaultRetryPolicy, nil)),
+	)
+	return c, prefix, nil
+}
+
+func sweepMonitors(string) error {
+	c, prefix, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	monitors, err := c.ListMonitors(ctx)
+	if err != nil {
+		return fmt.Errorf("listing monitors: %w", err)
+	}
+	var errs []error
+	for _, monitor := range monitors {
+		if !strings.HasPrefix(monitor.Name, prefix) {
+			continue
+		}
+		log.Printf("[INFO] Deleting monitor %d %s", monitor.Id, monitor.Name)
+		err := c.DeleteMonitor(ctx, strconv.FormatInt(monitor.Id, 10))
+		if err != nil && !client.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting monitor %d: %w", monitor.Id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func sweepStatusReports(string) error {
+	c, prefix, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	reports, err := c.ListStatusReports(ctx)
+	if err != nil {
+		return fmt.Errorf("listing status reports: %w", err)
+	}
+	var errs []error
+	for _, report := range reports {
+		if !strings.HasPrefix(report.Title, prefix) {
+			continue
+		}
+		log.Printf("[INFO] Deleting status report %d %s", report.Id, report.Title)
+		err := c.DeleteStatusReport(ctx, strconv.FormatInt(report.Id, 10))
+		if err != nil && !client.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("deleting status report %d: %w", report.Id, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sweepStatusPages only reports the leaked pages, the API cannot delete them.
+func sweepStatusPages(string) error {
+	c, prefix, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	pages, err := c.ListPages(context.Background())
+	if err != nil {
+		return fmt.Errorf("listing status pages: %w", err)
+	}
+	for _, page := range pages {
+		if strings.HasPrefix(page.Title, prefix) || strings.HasPrefix(page.Slug, prefix) {
+			log.Printf("[WARN] Status page %d %s cannot be deleted through the API, delete it from the dashboard", page.Id, page.Slug)
+		}
+	}
+	return nil
+}
+
+// sweepNotifications only reports the leaked notifications, the API cannot
+// delete them.
+func sweepNotifications(string) error {
+	c, prefix, err := sweeperClient()
+	if err != nil {
+		return err
+	}
+
+	notifications, err := c.ListNotifications(context.Background())
+	if err != nil {
+		return fmt.Errorf("listing notifications: %w", err)
+	}
+	for _, notification := range notifications {
+		if strings.HasPrefix(notification.Name, prefix) {
+			log.Printf("[WARN] Notification %d %s cannot be deleted through the API, delete it from the dashboard", notification.Id, notification.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package resource_incident
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var timestampRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:\d{2})$`)
+
+func IncidentResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"acknowledged": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If the incident is acknowledged. Left unset, the current state of the incident is kept.",
+				MarkdownDescription: "If the incident is acknowledged. Left unset, the current state of the incident is kept.",
+			},
+			"acknowledged_at": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The date the incident was acknowledged in ISO8601 format, defaults to the time of the apply",
+				MarkdownDescription: "The date the incident was acknowledged in ISO8601 format, defaults to the time of the apply",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(timestampRegexp, "must be an ISO8601 timestamp"),
+				},
+			},
+			"acknowledged_by": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The user who acknowledged the incident",
+				MarkdownDescription: "The user who acknowledged the incident",
+			},
+			"id": schema.NumberAttribute{
+				Required:            true,
+				Description:         "The id of the existing incident to manage",
+				MarkdownDescription: "The id of the existing incident to manage",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.RequiresReplace(),
+				},
+			},
+			"monitor_id": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The id of the monitor associated with the incident",
+				MarkdownDescription: "The id of the monitor associated with the incident",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"resolved": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If the incident is resolved. Left unset, the current state of the incident is kept.",
+				MarkdownDescription: "If the incident is resolved. Left unset, the current state of the incident is kept.",
+			},
+			"resolved_at": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The date the incident was resolved in ISO8601 format, defaults to the time of the apply",
+				MarkdownDescription: "The date the incident was resolved in ISO8601 format, defaults to the time of the apply",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(timestampRegexp, "must be an ISO8601 timestamp"),
+				},
+			},
+			"resolved_by": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The user who resolved the incident",
+				MarkdownDescription: "The user who resolved the incident",
+			},
+			"started_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The date the incident started",
+				MarkdownDescription: "The date the incident started",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+type IncidentModel struct {
+	Acknowledged   types.Bool   `tfsdk:"acknowledged"`
+	AcknowledgedAt types.String `tfsdk:"acknowledged_at"`
+	AcknowledgedBy types.Number `tfsdk:"acknowledged_by"`
+	Id             types.Number `tfsdk:"id"`
+	MonitorId      types.Number `tfsdk:"monitor_id"`
+	Resolved       types.Bool   `tfsdk:"resolved"`
+	ResolvedAt     types.String `tfsdk:"resolved_at"`
+	ResolvedBy     types.Number `tfsdk:"resolved_by"`
+	StartedAt      types.String `tfsdk:"started_at"`
+}
@@ -0,0 +1,147 @@
+package resource_monitor
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var jsonPathPattern = regexp.MustCompile(`^\$`)
+
+// Comparisons accepted by each kind of assertion.
+var (
+	NumberComparisons = []string{"eq", "not_eq", "gt", "gte", "lt", "lte"}
+	StringComparisons = []string{"eq", "not_eq", "gt", "gte", "lt", "lte", "contains", "not_contains", "empty", "not_empty"}
+)
+
+type StatusAssertionModel struct {
+	Compare types.String `tfsdk:"compare"`
+	Target  types.Int64  `tfsdk:"target"`
+}
+
+type HeaderAssertionModel struct {
+	Key     types.String `tfsdk:"key"`
+	Compare types.String `tfsdk:"compare"`
+	Target  types.String `tfsdk:"target"`
+}
+
+type TextBodyAssertionModel struct {
+	Compare types.String `tfsdk:"compare"`
+	Target  types.String `tfsdk:"target"`
+}
+
+type JsonBodyAssertionModel struct {
+	Path    types.String `tfsdk:"path"`
+	Compare types.String `tfsdk:"compare"`
+	Target  types.String `tfsdk:"target"`
+}
+
+// Element types of the assertion lists, shared with the monitor data sources.
+var (
+	StatusAssertionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"compare": types.StringType,
+		"target":  types.Int64Type,
+	}}
+	HeaderAssertionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"key":     types.StringType,
+		"compare": types.StringType,
+		"target":  types.StringType,
+	}}
+	TextBodyAssertionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"compare": types.StringType,
+		"target":  types.StringType,
+	}}
+	JsonBodyAssertionType = types.ObjectType{AttrTypes: map[string]attr.Type{
+		"path":    types.StringType,
+		"compare": types.StringType,
+		"target":  types.StringType,
+	}}
+)
+
+func assertionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"status_assertions": assertionList(StatusAssertionType, "Assertions on the status code of the response", map[string]schema.Attribute{
+			"compare": compareAttribute(NumberComparisons),
+			"target": schema.Int64Attribute{
+				Required:            true,
+				Description:         "The expected status code, between 100 and 599",
+				MarkdownDescription: "The expected status code, between 100 and 599",
+				Validators: []validator.Int64{
+					int64validator.Between(100, 599),
+				},
+			},
+		}),
+		"header_assertions": assertionList(HeaderAssertionType, "Assertions on a header of the response", map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the header to check",
+				MarkdownDescription: "The name of the header to check",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"compare": compareAttribute(StringComparisons),
+			"target":  targetAttribute(),
+		}),
+		"text_body_assertions": assertionList(TextBodyAssertionType, "Assertions on the body of the response", map[string]schema.Attribute{
+			"compare": compareAttribute(StringComparisons),
+			"target":  targetAttribute(),
+		}),
+		"json_body_assertions": assertionList(JsonBodyAssertionType, "Assertions on a value of the JSON body of the response", map[string]schema.Attribute{
+			"path": schema.StringAttribute{
+				Required:            true,
+				Description:         "The JSONPath of the value to check, such as $.status",
+				MarkdownDescription: "The JSONPath of the value to check, such as `$.status`",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(jsonPathPattern, "must be a JSONPath starting with $"),
+				},
+			},
+			"compare": compareAttribute(StringComparisons),
+			"target":  targetAttribute(),
+		}),
+	}
+}
+
+// assertionList is a list of assertions defaulting to an empty list, so
+// removing every assertion from the configuration removes them from the
+// monitor.
+func assertionList(elementType types.ObjectType, description string, attributes map[string]schema.Attribute) schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+		Optional:            true,
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+		Default:             listdefault.StaticValue(types.ListValueMust(elementType, []attr.Value{})),
+	}
+}
+
+func compareAttribute(comparisons []string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Required:            true,
+		Description:         "The comparison to run",
+		MarkdownDescription: "The comparison to run",
+		Validators: []validator.String{
+			stringvalidator.OneOf(comparisons...),
+		},
+	}
+}
+
+func targetAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "The target value, not needed by empty and not_empty",
+		MarkdownDescription: "The target value, not needed by `empty` and `not_empty`",
+		Default:             stringdefault.StaticString(""),
+	}
+}
@@ -0,0 +1,65 @@
+package resource_monitor
+
+import (
+	"slices"
+
+	"github.com/openstatusHQ/terraform-provider-openstatus/client"
+)
+
+// RegionGroups are the aliases accepted in regions, each standing for every
+// region of its continents.
+var RegionGroups = map[string][]string{
+	"all":           {client.Africa, client.Asia, client.Europe, client.NorthAmerica, client.Oceania, client.SouthAmerica},
+	"africa":        {client.Africa},
+	"apac":          {client.Asia, client.Oceania},
+	"asia":          {client.Asia},
+	"europe":        {client.Europe},
+	"north-america": {client.NorthAmerica},
+	"oceania":       {client.Oceania},
+	"south-america": {client.SouthAmerica},
+}
+
+// RegionGroupNames returns the aliases of RegionGroups, sorted.
+func RegionGroupNames() []string {
+	names := make([]string, 0, len(RegionGroups))
+	for group := range RegionGroups {
+		names = append(names, group)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// regionValues are the codes and group aliases accepted in regions and
+// exclude_regions.
+func regionValues() []string {
+	values := append(client.RegionCodes(), RegionGroupNames()...)
+	slices.Sort(values)
+	return values
+}
+
+// ExpandRegions replaces the groups of regions by their regions, and removes
+// the regions and groups of exclude. The result is sorted by code, whatever
+// the order of regions.
+func ExpandRegions(regions, exclude []string) []string {
+	excluded := expand(exclude)
+	expanded := []string{}
+	for _, code := range expand(regions) {
+		if !slices.Contains(excluded, code) {
+			expanded = append(expanded, code)
+		}
+	}
+	return expanded
+}
+
+func expand(values []string) []string {
+	var codes []string
+	for _, region := range client.Regions {
+		for _, value := range values {
+			if value == region.Code || slices.Contains(RegionGroups[value], region.Continent) {
+				codes = append(codes, region.Code)
+				break
+			}
+		}
+	}
+	return codes
+}
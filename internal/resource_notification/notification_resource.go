@@ -0,0 +1,204 @@
+package resource_notification
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var (
+	emailRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+	smsRegexp   = regexp.MustCompile(`^([+]?[\s0-9]+)?(\d{3}|[(]?[0-9]+[)])?([-]?[\s]?[0-9])+$`)
+	uriRegexp   = regexp.MustCompile(`^https?://\S+$`)
+)
+
+func NotificationResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The id of the notification",
+				MarkdownDescription: "The id of the notification",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitors": schema.ListAttribute{
+				ElementType:         types.NumberType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The monitors that the notification is linked to",
+				MarkdownDescription: "The monitors that the notification is linked to",
+				Default:             listdefault.StaticValue(types.ListValueMust(types.NumberType, []attr.Value{})),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the notification",
+				MarkdownDescription: "The name of the notification",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The provider of the notification, derived from the configured channel",
+				MarkdownDescription: "The provider of the notification, derived from the configured channel",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"discord": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"webhook_url": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The Discord webhook url",
+						MarkdownDescription: "The Discord webhook url",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(uriRegexp, "must be an http(s) url"),
+						},
+					},
+				},
+				Description:         "Send the notification to a Discord channel",
+				MarkdownDescription: "Send the notification to a Discord channel",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("webhook_url")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"email": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"address": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The email address to notify",
+						MarkdownDescription: "The email address to notify",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(emailRegexp, "must be a valid email address"),
+						},
+					},
+				},
+				Description:         "Send the notification by email",
+				MarkdownDescription: "Send the notification by email",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("address")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"pagerduty": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"integration_key": schema.StringAttribute{
+						Optional:            true,
+						Sensitive:           true,
+						Description:         "The PagerDuty integration key",
+						MarkdownDescription: "The PagerDuty integration key",
+						Validators: []validator.String{
+							stringvalidator.LengthAtLeast(1),
+						},
+					},
+				},
+				Description:         "Send the notification to PagerDuty",
+				MarkdownDescription: "Send the notification to PagerDuty",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("integration_key")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"slack": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"webhook_url": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The Slack webhook url",
+						MarkdownDescription: "The Slack webhook url",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(uriRegexp, "must be an http(s) url"),
+						},
+					},
+				},
+				Description:         "Send the notification to a Slack channel",
+				MarkdownDescription: "Send the notification to a Slack channel",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("webhook_url")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"sms": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"phone_number": schema.StringAttribute{
+						Optional:            true,
+						Description:         "The phone number to notify",
+						MarkdownDescription: "The phone number to notify",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(smsRegexp, "must be a valid phone number"),
+						},
+					},
+				},
+				Description:         "Send the notification by SMS",
+				MarkdownDescription: "Send the notification by SMS",
+				Validators: []validator.Object{
+					objectvalidator.AlsoRequires(path.MatchRelative().AtName("phone_number")),
+				},
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+type NotificationModel struct {
+	Discord      *DiscordModel   `tfsdk:"discord"`
+	Email        *EmailModel     `tfsdk:"email"`
+	Id           types.Number    `tfsdk:"id"`
+	Monitors     types.List      `tfsdk:"monitors"`
+	Name         types.String    `tfsdk:"name"`
+	Pagerduty    *PagerdutyModel `tfsdk:"pagerduty"`
+	ProviderType types.String    `tfsdk:"provider_type"`
+	Slack        *SlackModel     `tfsdk:"slack"`
+	Sms          *SmsModel       `tfsdk:"sms"`
+}
+
+type DiscordModel struct {
+	WebhookUrl types.String `tfsdk:"webhook_url"`
+}
+
+type EmailModel struct {
+	Address types.String `tfsdk:"address"`
+}
+
+type PagerdutyModel struct {
+	IntegrationKey types.String `tfsdk:"integration_key"`
+}
+
+type SlackModel struct {
+	WebhookUrl types.String `tfsdk:"webhook_url"`
+}
+
+type SmsModel struct {
+	PhoneNumber types.String `tfsdk:"phone_number"`
+}
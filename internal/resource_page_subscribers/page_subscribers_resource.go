@@ -0,0 +1,48 @@
+package resource_page_subscribers
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+var emailRegexp = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+func PageSubscribersResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"emails": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				Description:         "The email addresses subscribed to the page",
+				MarkdownDescription: "The email addresses subscribed to the page",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(emailRegexp, "must be a valid email address"),
+					),
+				},
+			},
+			"page_id": schema.NumberAttribute{
+				Required:            true,
+				Description:         "The id of the page",
+				MarkdownDescription: "The id of the page",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+type PageSubscribersModel struct {
+	Emails types.Set    `tfsdk:"emails"`
+	PageId types.Number `tfsdk:"page_id"`
+}
@@ -0,0 +1,99 @@
+package resource_status_page
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func StatusPageResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"custom_domain": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The custom domain of the page. To be configured within the dashboard.",
+				MarkdownDescription: "The custom domain of the page. To be configured within the dashboard.",
+				Default:             stringdefault.StaticString(""),
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The description of the page",
+				MarkdownDescription: "The description of the page",
+				Default:             stringdefault.StaticString(""),
+			},
+			"icon": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The icon of the page",
+				MarkdownDescription: "The icon of the page",
+				Default:             stringdefault.StaticString(""),
+			},
+			"id": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The id of the page",
+				MarkdownDescription: "The id of the page",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitors": schema.ListAttribute{
+				ElementType:         types.NumberType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ids of the monitors shown on the page",
+				MarkdownDescription: "The ids of the monitors shown on the page",
+				Default:             listdefault.StaticValue(types.ListValueMust(types.NumberType, []attr.Value{})),
+			},
+			"password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "The password protecting the page. Used with password_protected.",
+				MarkdownDescription: "The password protecting the page. Used with `password_protected`.",
+			},
+			"password_protected": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "If the page is password protected",
+				MarkdownDescription: "If the page is password protected",
+				Default:             booldefault.StaticBool(false),
+			},
+			"slug": schema.StringAttribute{
+				Required:            true,
+				Description:         "The slug of the page",
+				MarkdownDescription: "The slug of the page",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				Description:         "The title of the page",
+				MarkdownDescription: "The title of the page",
+			},
+		},
+	}
+}
+
+type StatusPageModel struct {
+	CustomDomain      types.String `tfsdk:"custom_domain"`
+	Description       types.String `tfsdk:"description"`
+	Icon              types.String `tfsdk:"icon"`
+	Id                types.Number `tfsdk:"id"`
+	Monitors          types.List   `tfsdk:"monitors"`
+	Password          types.String `tfsdk:"password"`
+	PasswordProtected types.Bool   `tfsdk:"password_protected"`
+	Slug              types.String `tfsdk:"slug"`
+	Title             types.String `tfsdk:"title"`
+}
@@ -0,0 +1,132 @@
+package resource_status_report
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/numberplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func StatusReportResourceSchema(ctx context.Context) schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.NumberAttribute{
+				Computed:            true,
+				Description:         "The id of the status report",
+				MarkdownDescription: "The id of the status report",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Required:            true,
+				Description:         "The message of the current status of the incident. Changing it posts a new update.",
+				MarkdownDescription: "The message of the current status of the incident. Changing it posts a new update.",
+			},
+			"monitor_ids": schema.ListAttribute{
+				ElementType:         types.NumberType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ids of the monitors the report refers to",
+				MarkdownDescription: "The ids of the monitors the report refers to",
+				Default:             listdefault.StaticValue(types.ListValueMust(types.NumberType, []attr.Value{})),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"page_id": schema.NumberAttribute{
+				Optional:            true,
+				Description:         "The id of the page the status report belongs to",
+				MarkdownDescription: "The id of the page the status report belongs to",
+				PlanModifiers: []planmodifier.Number{
+					numberplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Required:            true,
+				Description:         "The current status of the report. Changing it posts a new update.",
+				MarkdownDescription: "The current status of the report. Changing it posts a new update.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"investigating",
+						"identified",
+						"monitoring",
+						"resolved",
+					),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				Description:         "The title of the status report",
+				MarkdownDescription: "The title of the status report",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"updates": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"date": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The date of the update in ISO8601 format",
+							MarkdownDescription: "The date of the update in ISO8601 format",
+						},
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The id of the update",
+							MarkdownDescription: "The id of the update",
+						},
+						"message": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The message of the update",
+							MarkdownDescription: "The message of the update",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The status of the update",
+							MarkdownDescription: "The status of the update",
+						},
+					},
+				},
+				Computed:            true,
+				Description:         "The history of updates posted to the report, oldest first",
+				MarkdownDescription: "The history of updates posted to the report, oldest first",
+			},
+		},
+	}
+}
+
+type StatusReportModel struct {
+	Id         types.Number `tfsdk:"id"`
+	Message    types.String `tfsdk:"message"`
+	MonitorIds types.List   `tfsdk:"monitor_ids"`
+	PageId     types.Number `tfsdk:"page_id"`
+	Status     types.String `tfsdk:"status"`
+	Title      types.String `tfsdk:"title"`
+	Updates    types.List   `tfsdk:"updates"`
+}
+
+type UpdatesModel struct {
+	Date    types.String `tfsdk:"date"`
+	Id      types.String `tfsdk:"id"`
+	Message types.String `tfsdk:"message"`
+	Status  types.String `tfsdk:"status"`
+}
+
+func (m UpdatesModel) AttributeTypes(ctx context.Context) map[string]attr.Type {
+	return map[string]attr.Type{
+		"date":    types.StringType,
+		"id":      types.StringType,
+		"message": types.StringType,
+		"status":  types.StringType,
+	}
+}
@@ -16,32 +16,22 @@ resource "openstatus_monitor" "my_second_monitor" {
   regions        = ["iad", "jnb", "ams"]
   periodicity    = "10m"
   name           = "test-monitor-terraform-http"
-  degraded_after = 30
-  timeout        = 13
+  degraded_after = 13
+  timeout        = 30
   active         = false
   description    = "This is a test monitor"
   headers = [
     { key = "test-key", value = "test-value" },
   ]
-  assertions = [
-    {
-      type    = "status"
-      target  = "200"
-      key     = ""
-      compare = "eq"
-    },
-    {
-      type    = "status"
-      target  = "300"
-      key     = ""
-      compare = "eq"
-    },
-    {
-      type    = "header"
-      target  = "test"
-      key     = "test"
-      compare = "eq"
-    }
+  status_assertions = [
+    { compare = "gte", target = 200 },
+    { compare = "lt", target = 300 },
+  ]
+  header_assertions = [
+    { key = "test", compare = "eq", target = "test" },
+  ]
+  json_body_assertions = [
+    { path = "$.status", compare = "eq", target = "ok" },
   ]
 }
 
@@ -51,8 +41,8 @@ resource "openstatus_monitor" "my_monitor" {
   regions        = ["iad", "jnb", "ams"]
   periodicity    = "10m"
   name           = "test-monitor-terraform-tcp"
-  degraded_after = 30
-  timeout        = 13
+  degraded_after = 13
+  timeout        = 30
   active         = false
   description    = "This is a test monitor"
   type           = "tcp"